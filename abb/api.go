@@ -26,6 +26,7 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"abb-free-at-home/abbconnection"
 	"abb-free-at-home/abbgraphql"
@@ -206,12 +207,17 @@ func convertToDataFormat(query abbgraphql.SystemsQuery) DataFormat {
 		var system System
 		system.SysApName = systemQuery.DtId
 		system.ConnectionOK = systemQuery.ConnectionStatusService.IoTHub.Value
+		system.ConnectionKnown = true
+		system.FirmwareVersion = systemQuery.AttributesService.FirmwareVersion
+		system.Version = systemQuery.AttributesService.Version
 		system.Devices = make(map[string]Device)
 		for _, asset := range systemQuery.Assets {
 			var device Device
 			device.DisplayName = asset.Name.En
 			if asset.Label != "" {
 				device.DisplayName = asset.Label
+			} else {
+				device.Names = asset.Name.Translations()
 			}
 			device.Location = asset.IsLocated.DtId
 			if asset.DeviceFHRF.BatteryStatus != "" {
@@ -223,6 +229,16 @@ func convertToDataFormat(query abbgraphql.SystemsQuery) DataFormat {
 				device.Battery = &b
 			}
 			device.Connectivity = asset.DeviceFHRF.AttributesService.Connectivity
+			device.ArticleNumber = asset.AttributesService.ArticleNumber
+			device.DeviceType = asset.AttributesService.DeviceType
+			device.FirmwareVersion = asset.AttributesService.FirmwareVersion
+			device.Manufacturer = asset.AttributesService.Manufacturer
+			device.SerialNumber = asset.SerialNumber
+			device.Connection = "wired"
+			if asset.DeviceFHRF.DtId != "" {
+				device.Connection = "wireless"
+			}
+			device.LastSeen = asset.AttributesService.LastSeen
 
 			device.Channels = make(map[string]Channel)
 			for _, ch := range asset.Channels {
@@ -230,8 +246,12 @@ func convertToDataFormat(query abbgraphql.SystemsQuery) DataFormat {
 				channel.DisplayName = ch.Name.En
 				if ch.Label != "" {
 					channel.DisplayName = ch.Label
+				} else {
+					channel.Names = ch.Name.Translations()
 				}
 				channel.FunctionId = ch.FunctionId
+				channel.FunctionName = ch.Name.En
+				channel.FunctionNames = ch.Name.Translations()
 				channel.Outputs = make(map[string]Output)
 				channel.Inputs = make(map[string]Input)
 				for _, output := range ch.Outputs {
@@ -242,6 +262,13 @@ func convertToDataFormat(query abbgraphql.SystemsQuery) DataFormat {
 					}
 					out.PairingId = int(pairingId)
 					out.Value = output.Value.DataPointService.RequestDataPointValue.Value
+					out.Dpt = output.Value.Dpt
+					if t := output.Value.DataPointService.RequestDataPointValue.Time; t != "" {
+						out.Time, err = time.Parse(time.RFC3339Nano, t)
+						if err != nil {
+							log.Printf("Error parsing datapoint time %s: %v", t, err)
+						}
+					}
 					channel.Outputs[output.Key] = out
 				}
 				for _, input := range ch.Inputs {
@@ -265,7 +292,6 @@ func convertToDataFormat(query abbgraphql.SystemsQuery) DataFormat {
 
 func (api *Api) getConfigurationLegacy() (DataFormat, error) {
 	config := DataFormat{}
-	systems := make(map[string]System)
 
 	body, code, err := api.request(abbconnection.REQUEST_METHOD_GET, API_PATH_CONFIGURATION, nil)
 	if err != nil {
@@ -274,10 +300,78 @@ func (api *Api) getConfigurationLegacy() (DataFormat, error) {
 	if code != http.StatusOK {
 		return config, fmt.Errorf("configuration %v response with code %d", api.BaseUrl+API_PATH_CONFIGURATION, code)
 	}
+	return parseConfigurationLegacy(body)
+}
+
+func parseConfigurationLegacy(body []byte) (DataFormat, error) {
+	systems := make(map[string]System)
+	if err := json.Unmarshal(body, &systems); err != nil {
+		return DataFormat{}, err
+	}
+	for id, system := range systems {
+		// The local API is served by the SysAP itself, so it is connected if it answered.
+		system.ConnectionOK = true
+		system.ConnectionKnown = true
+		systems[id] = system
+	}
+	return DataFormat{Systems: systems}, nil
+}
+
+// ReadDatapoints reads the current values of the output datapoints.
+func (api *Api) ReadDatapoints(datapoints []appdb.Datapoint) ([]abbgraphql.DataPoint, error) {
+	if api.Auth.AuthorizedClient == nil {
+		return api.readDatapointsLegacy(datapoints)
+	}
+	return abbgraphql.GetDataPointValues(api.Auth.AuthorizedClient, datapoints)
+}
 
-	err = json.Unmarshal(body, &systems)
+// readDatapointsLegacy reads the datapoints one by one. Datapoints that cannot be read are
+// logged and skipped, so that a single stale datapoint does not block the others.
+func (api *Api) readDatapointsLegacy(datapoints []appdb.Datapoint) ([]abbgraphql.DataPoint, error) {
+	var values []abbgraphql.DataPoint
+	var lastErr error
+	for _, dp := range datapoints {
+		value, ok, err := api.readDatapointLegacy(dp)
+		if err != nil {
+			log.Printf("Error reading datapoint: %v", err)
+			lastErr = err
+			continue
+		}
+		if ok {
+			values = append(values, value)
+		}
+	}
+	if len(values) == 0 && lastErr != nil {
+		return nil, fmt.Errorf("no datapoint could be read, last error: %v", lastErr)
+	}
+	return values, nil
+}
 
-	return DataFormat{Systems: systems}, err
+func (api *Api) readDatapointLegacy(dp appdb.Datapoint) (abbgraphql.DataPoint, bool, error) {
+	dpPath := dp.SystemID + "/" + dp.DeviceID + "." + dp.ChannelID + "." + dp.Datapoint
+	body, code, err := api.request(abbconnection.REQUEST_METHOD_GET, API_PATH_UPSTREAM+dpPath, nil)
+	if err != nil {
+		return abbgraphql.DataPoint{}, false, fmt.Errorf("requesting datapoint %v: %v", dpPath, err)
+	}
+	if code != http.StatusOK {
+		return abbgraphql.DataPoint{}, false, fmt.Errorf("datapoint %v response with code %d", dpPath, code)
+	}
+	var response map[string]struct {
+		Values []string `json:"values"`
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return abbgraphql.DataPoint{}, false, fmt.Errorf("unmarshalling datapoint %v: %v", dpPath, err)
+	}
+	system, ok := response[dp.SystemID]
+	if !ok || len(system.Values) == 0 {
+		return abbgraphql.DataPoint{}, false, nil
+	}
+	return abbgraphql.DataPoint{
+		Value:         system.Values[0],
+		SerialNumber:  dp.DeviceID,
+		ChannelNumber: dp.ChannelID,
+		DatapointId:   dp.Datapoint,
+	}, true, nil
 }
 
 func (api *Api) WriteDatapoint(system string, deviceId string, channel string, datapoint string, value float64) error {
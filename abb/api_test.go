@@ -0,0 +1,58 @@
+package abb
+
+import (
+	"abb-free-at-home/abbgraphql"
+	"encoding/json"
+	"strconv"
+	"testing"
+)
+
+func TestConvertToDataFormatConnection(t *testing.T) {
+	tests := []struct {
+		name      string
+		connected bool
+	}{
+		{"connected", true},
+		{"disconnected", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var query abbgraphql.SystemsQuery
+			body := `{"systems": [{"dtId": "ABB700000001", "connectionStatusService": {"ioTHub": {"value": ` + strconv.FormatBool(tt.connected) + `}}}]}`
+			if err := json.Unmarshal([]byte(body), &query); err != nil {
+				t.Fatalf("unmarshalling query: %v", err)
+			}
+			system := convertToDataFormat(query).Systems["ABB700000001"]
+			if !system.ConnectionKnown {
+				t.Errorf("connection state not known")
+			}
+			if system.ConnectionOK != tt.connected {
+				t.Errorf("ConnectionOK = %v, want %v", system.ConnectionOK, tt.connected)
+			}
+		})
+	}
+}
+
+func TestParseConfigurationLegacyConnection(t *testing.T) {
+	body := `{"00000000-0000-0000-0000-000000000000": {"sysapName": "Home", "devices": {}}}`
+	data, err := parseConfigurationLegacy([]byte(body))
+	if err != nil {
+		t.Fatalf("parsing configuration: %v", err)
+	}
+	system, ok := data.Systems["00000000-0000-0000-0000-000000000000"]
+	if !ok {
+		t.Fatalf("system missing")
+	}
+	if system.SysApName != "Home" {
+		t.Errorf("SysApName = %q, want %q", system.SysApName, "Home")
+	}
+	if !system.ConnectionKnown || !system.ConnectionOK {
+		t.Errorf("answering SysAP not reported as connected: known %v, ok %v", system.ConnectionKnown, system.ConnectionOK)
+	}
+}
+
+func TestParseConfigurationLegacyMalformed(t *testing.T) {
+	if _, err := parseConfigurationLegacy([]byte(`[]`)); err == nil {
+		t.Errorf("expected error for malformed configuration")
+	}
+}
@@ -20,6 +20,7 @@ package abb
 import (
 	"log"
 	"strings"
+	"time"
 )
 
 type WsObject map[string]struct {
@@ -37,16 +38,23 @@ type Input struct {
 	PairingId int `json:"pairingID"`
 }
 type Output struct {
-	Value     string `json:"value"`
-	PairingId int    `json:"pairingID"`
+	Value     string    `json:"value"`
+	PairingId int       `json:"pairingID"`
+	Dpt       string    `json:"-"`
+	Time      time.Time `json:"-"` // When the value last changed at ABB. Zero if unknown.
 }
 type Channel struct {
-	DisplayName interface{}       `json:"displayName"`
-	Floor       interface{}       `json:"floor"`
-	Inputs      map[string]Input  `json:"inputs"`
-	Outputs     map[string]Output `json:"outputs"`
-	FunctionId  string            `json:"functionID"`
-	Room        interface{}       `json:"room"`
+	DisplayName  interface{}       `json:"displayName"`
+	Floor        interface{}       `json:"floor"`
+	Inputs       map[string]Input  `json:"inputs"`
+	Outputs      map[string]Output `json:"outputs"`
+	FunctionId   string            `json:"functionID"`
+	Room         interface{}       `json:"room"`
+	FunctionName string            // Static name of the channel type
+	// Translations of the name and of the static name of the channel type, keyed by
+	// language code. Names are only translated if not set by the user on the SysAP.
+	Names         map[string]string
+	FunctionNames map[string]string
 }
 type Device struct {
 	Channels     map[string]Channel `json:"channels"`
@@ -57,12 +65,24 @@ type Device struct {
 	Battery      *int64
 	Connectivity string
 	Location     string
+	Names        map[string]string // Translations, see Channel.Names
+
+	ArticleNumber   string
+	DeviceType      string
+	FirmwareVersion string
+	Manufacturer    string
+	SerialNumber    string
+	Connection      string // "wireless" or "wired", empty if unknown
+	LastSeen        string
 }
 type System struct {
-	ConnectionOK bool
-	Devices      map[string]Device `json:"devices"`
-	SysApName    string            `json:"sysapName"`
-	Floorplan    Floors            `json:"floorplan"`
+	ConnectionOK    bool
+	ConnectionKnown bool // Whether ConnectionOK was reported at all.
+	FirmwareVersion string
+	Version         string
+	Devices         map[string]Device `json:"devices"`
+	SysApName       string            `json:"sysapName"`
+	Floorplan       Floors            `json:"floorplan"`
 }
 type Floors struct {
 	Floors map[string]Floor `json:"floors"`
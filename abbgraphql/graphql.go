@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"math"
 	"net/http"
+	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/eliona-smart-building-assistant/go-utils/log"
@@ -19,6 +21,7 @@ const wsURL = "wss://apps.eu.mybuildings.abb.com/adtg-ws/graphql"
 
 type LocationsQuery struct {
 	ISystemFH []struct {
+		DtId      string `graphql:"dtId"`
 		Locations []struct {
 			DtId         string `graphql:"dtId"`
 			Label        string `graphql:"label"`
@@ -41,6 +44,34 @@ func GetLocations(httpClient *http.Client) (LocationsQuery, error) {
 	return query, nil
 }
 
+// Name is a name translated by ABB to the languages supported by free@home.
+type Name struct {
+	En string `graphql:"en"`
+	De string `graphql:"de"`
+	Fr string `graphql:"fr"`
+	It string `graphql:"it"`
+	Nl string `graphql:"nl"`
+	Es string `graphql:"es"`
+}
+
+// Translations returns the non-empty translations keyed by language code.
+func (n Name) Translations() map[string]string {
+	translations := make(map[string]string)
+	for lang, name := range map[string]string{
+		"en": n.En,
+		"de": n.De,
+		"fr": n.Fr,
+		"it": n.It,
+		"nl": n.Nl,
+		"es": n.Es,
+	} {
+		if name != "" {
+			translations[lang] = name
+		}
+	}
+	return translations
+}
+
 type SystemsQuery struct {
 	Systems []struct {
 		ConnectionStatusService struct {
@@ -48,30 +79,39 @@ type SystemsQuery struct {
 				Value bool `graphql:"value"`
 			} `graphql:"IoTHub"`
 		} `graphql:"ConnectionStatusService"`
-		DtId   string `graphql:"dtId"`
+		DtId              string `graphql:"dtId"`
+		AttributesService struct {
+			FirmwareVersion string `graphql:"firmwareVersion: get(key:\"firmwareVersion\")"`
+			Version         string `graphql:"version: get(key:\"version\")"`
+		} `graphql:"AttributesService"`
 		Assets []struct {
 			IsLocated struct {
 				DtId string `graphql:"dtId"`
 			} `graphql:"IsLocated"`
 			SerialNumber string `graphql:"serialNumber"`
-			Label        string `graphql:"label"` // Custom name set on sysAP
-			Name         struct {
-				En string `graphql:"en"`
-			} `graphql:"Name"`
+			// Attributes not available for a device are returned empty.
+			AttributesService struct {
+				ArticleNumber   string `graphql:"articleNumber: get(key:\"articleNumber\")"`
+				DeviceType      string `graphql:"deviceType: get(key:\"deviceType\")"`
+				FirmwareVersion string `graphql:"firmwareVersion: get(key:\"firmwareVersion\")"`
+				Manufacturer    string `graphql:"manufacturer: get(key:\"manufacturer\")"`
+				LastSeen        string `graphql:"lastSeen: get(key:\"lastSeen\")"`
+			} `graphql:"AttributesService"`
+			Label      string `graphql:"label"` // Custom name set on sysAP
+			Name       Name   `graphql:"Name"`
 			DeviceFHRF struct {
+				DtId              string `graphql:"dtId"` // Only set for wireless devices
 				BatteryStatus     string `graphql:"batteryStatus"`
 				AttributesService struct {
 					Connectivity string `graphql:"get(key:\"connectivity\")"`
 				} `graphql:"AttributesService"`
 			} `graphql:"... on IDeviceFHRF"`
 			Channels []struct {
-				ChannelNumber int      `graphql:"channelNumber"`
-				FunctionId    string   `graphql:"functionId"`
-				Label         string   `graphql:"label"` // Custom name set on sysAP
-				Name          struct { // Static name, based on channel type
-					En string `graphql:"en"`
-				} `graphql:"Name"`
-				Outputs []struct {
+				ChannelNumber int    `graphql:"channelNumber"`
+				FunctionId    string `graphql:"functionId"`
+				Label         string `graphql:"label"` // Custom name set on sysAP
+				Name          Name   `graphql:"Name"`  // Static name, based on channel type
+				Outputs       []struct {
 					Key   string `graphql:"key"`
 					Value struct {
 						PairingId        string `graphql:"pairingId"`
@@ -359,6 +399,71 @@ func SubscribeDataPointValue(auth string, datapoints []appdb.Datapoint, ch chan<
 	return nil
 }
 
+type dataPointValuesQuery struct {
+	IDeviceFH []struct {
+		SerialNumber string `graphql:"serialNumber"`
+		Channels     []struct {
+			ChannelNumber int `graphql:"channelNumber"`
+			Outputs       []struct {
+				Key   string `graphql:"key"`
+				Value struct {
+					DataPointService struct {
+						RequestDataPointValue struct {
+							Value string `graphql:"value"`
+						} `graphql:"RequestDataPointValue"`
+					} `graphql:"DataPointService"`
+				} `graphql:"value"`
+			} `graphql:"outputs"`
+		} `graphql:"Channels(find: $channelFind)"`
+	} `graphql:"IDeviceFH(find: $deviceFind)"`
+}
+
+// GetDataPointValues reads the current values of the output datapoints in one query. It serves
+// as a fallback if the datapoint subscription is not available.
+func GetDataPointValues(httpClient *http.Client, datapoints []appdb.Datapoint) ([]DataPoint, error) {
+	client := getClient(httpClient)
+	requested := make(map[DataPoint]bool)
+	var serialNumbers, channelNumbers []string
+	for _, dp := range datapoints {
+		requested[DataPoint{SerialNumber: dp.DeviceID, ChannelNumber: dp.ChannelID, DatapointId: dp.Datapoint}] = true
+		if !slices.Contains(serialNumbers, dp.DeviceID) {
+			serialNumbers = append(serialNumbers, dp.DeviceID)
+		}
+		if !slices.Contains(channelNumbers, dp.ChannelID) {
+			channelNumbers = append(channelNumbers, dp.ChannelID)
+		}
+	}
+	if len(requested) == 0 {
+		return nil, nil
+	}
+	var query dataPointValuesQuery
+	variables := map[string]interface{}{
+		"deviceFind":  fmt.Sprintf("{'serialNumber': {'$in': %s}}", formatSlice(serialNumbers)),
+		"channelFind": fmt.Sprintf("{'channelNumber': {'$in': [%s]}}", strings.Join(channelNumbers, ",")),
+	}
+	if err := client.Query(context.Background(), &query, variables); err != nil {
+		return nil, fmt.Errorf("querying: %v", err)
+	}
+	var values []DataPoint
+	for _, device := range query.IDeviceFH {
+		for _, channel := range device.Channels {
+			for _, output := range channel.Outputs {
+				dp := DataPoint{
+					SerialNumber:  device.SerialNumber,
+					ChannelNumber: strconv.Itoa(channel.ChannelNumber),
+					DatapointId:   output.Key,
+				}
+				if !requested[dp] {
+					continue
+				}
+				dp.Value = output.Value.DataPointService.RequestDataPointValue.Value
+				values = append(values, dp)
+			}
+		}
+	}
+	return values, nil
+}
+
 type ConnectionStatus struct {
 	DtId      string `graphql:"dtId"`
 	Connected bool   `graphql:"connected"`
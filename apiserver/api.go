@@ -12,6 +12,7 @@ package apiserver
 import (
 	"context"
 	"net/http"
+	"time"
 )
 
 // ConfigurationAPIRouter defines the required methods for binding the api requests to a responses for the ConfigurationAPI
@@ -19,9 +20,24 @@ import (
 // pass the data to a ConfigurationAPIServicer to perform the required actions, then write the service results to the http response.
 type ConfigurationAPIRouter interface {
 	DeleteConfigurationById(http.ResponseWriter, *http.Request)
+	GetAssetPreviewByConfigId(http.ResponseWriter, *http.Request)
+	GetAttributeTransformationsByConfigId(http.ResponseWriter, *http.Request)
+	GetBufferStatusByConfigId(http.ResponseWriter, *http.Request)
 	GetConfigurationById(http.ResponseWriter, *http.Request)
 	GetConfigurations(http.ResponseWriter, *http.Request)
+	GetSuppressedAssetsByConfigId(http.ResponseWriter, *http.Request)
+	GetSyncJobById(http.ResponseWriter, *http.Request)
+	GetSystemAvailabilityByConfigId(http.ResponseWriter, *http.Request)
 	PostConfiguration(http.ResponseWriter, *http.Request)
+	PostConfigurationExport(http.ResponseWriter, *http.Request)
+	PostConfigurationImport(http.ResponseWriter, *http.Request)
+	PostDiscoveryByConfigId(http.ResponseWriter, *http.Request)
+	PostForgetSuppressedAssetsByConfigId(http.ResponseWriter, *http.Request)
+	PostMappingReconciliationByConfigId(http.ResponseWriter, *http.Request)
+	PostRecreateSuppressedAssetsByConfigId(http.ResponseWriter, *http.Request)
+	PostSyncJobByConfigId(http.ResponseWriter, *http.Request)
+	PostValueRefreshByConfigId(http.ResponseWriter, *http.Request)
+	PutAttributeTransformationByConfigId(http.ResponseWriter, *http.Request)
 	PutConfigurationById(http.ResponseWriter, *http.Request)
 }
 
@@ -46,9 +62,24 @@ type VersionAPIRouter interface {
 // and updated with the logic required for the API.
 type ConfigurationAPIServicer interface {
 	DeleteConfigurationById(context.Context, int64) (ImplResponse, error)
+	GetAssetPreviewByConfigId(context.Context, int64) (ImplResponse, error)
+	GetAttributeTransformationsByConfigId(context.Context, int64) (ImplResponse, error)
+	GetBufferStatusByConfigId(context.Context, int64) (ImplResponse, error)
 	GetConfigurationById(context.Context, int64) (ImplResponse, error)
 	GetConfigurations(context.Context) (ImplResponse, error)
+	GetSuppressedAssetsByConfigId(context.Context, int64) (ImplResponse, error)
+	GetSyncJobById(context.Context, int64, int64) (ImplResponse, error)
+	GetSystemAvailabilityByConfigId(context.Context, int64, time.Time, time.Time) (ImplResponse, error)
 	PostConfiguration(context.Context, Configuration) (ImplResponse, error)
+	PostConfigurationExport(context.Context, ConfigurationExportRequest) (ImplResponse, error)
+	PostConfigurationImport(context.Context, ConfigurationImportRequest) (ImplResponse, error)
+	PostDiscoveryByConfigId(context.Context, int64) (ImplResponse, error)
+	PostForgetSuppressedAssetsByConfigId(context.Context, int64, SuppressedAssetSelection) (ImplResponse, error)
+	PostMappingReconciliationByConfigId(context.Context, int64) (ImplResponse, error)
+	PostRecreateSuppressedAssetsByConfigId(context.Context, int64, SuppressedAssetSelection) (ImplResponse, error)
+	PostSyncJobByConfigId(context.Context, int64, SyncRequest) (ImplResponse, error)
+	PostValueRefreshByConfigId(context.Context, int64) (ImplResponse, error)
+	PutAttributeTransformationByConfigId(context.Context, int64, AttributeTransformation) (ImplResponse, error)
 	PutConfigurationById(context.Context, int64, Configuration) (ImplResponse, error)
 }
 
@@ -15,6 +15,7 @@ import (
 	"io"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/gorilla/mux"
 )
@@ -57,6 +58,21 @@ func (c *ConfigurationAPIController) Routes() Routes {
 			"/v1/configs/{config-id}",
 			c.DeleteConfigurationById,
 		},
+		"GetAssetPreviewByConfigId": Route{
+			strings.ToUpper("Get"),
+			"/v1/configs/{config-id}/preview",
+			c.GetAssetPreviewByConfigId,
+		},
+		"GetAttributeTransformationsByConfigId": Route{
+			strings.ToUpper("Get"),
+			"/v1/configs/{config-id}/transformations",
+			c.GetAttributeTransformationsByConfigId,
+		},
+		"GetBufferStatusByConfigId": Route{
+			strings.ToUpper("Get"),
+			"/v1/configs/{config-id}/buffer",
+			c.GetBufferStatusByConfigId,
+		},
 		"GetConfigurationById": Route{
 			strings.ToUpper("Get"),
 			"/v1/configs/{config-id}",
@@ -67,11 +83,71 @@ func (c *ConfigurationAPIController) Routes() Routes {
 			"/v1/configs",
 			c.GetConfigurations,
 		},
+		"GetSuppressedAssetsByConfigId": Route{
+			strings.ToUpper("Get"),
+			"/v1/configs/{config-id}/suppressed-assets",
+			c.GetSuppressedAssetsByConfigId,
+		},
+		"GetSyncJobById": Route{
+			strings.ToUpper("Get"),
+			"/v1/configs/{config-id}/sync/{job-id}",
+			c.GetSyncJobById,
+		},
+		"GetSystemAvailabilityByConfigId": Route{
+			strings.ToUpper("Get"),
+			"/v1/configs/{config-id}/availability",
+			c.GetSystemAvailabilityByConfigId,
+		},
 		"PostConfiguration": Route{
 			strings.ToUpper("Post"),
 			"/v1/configs",
 			c.PostConfiguration,
 		},
+		"PostConfigurationExport": Route{
+			strings.ToUpper("Post"),
+			"/v1/configs/export",
+			c.PostConfigurationExport,
+		},
+		"PostConfigurationImport": Route{
+			strings.ToUpper("Post"),
+			"/v1/configs/import",
+			c.PostConfigurationImport,
+		},
+		"PostDiscoveryByConfigId": Route{
+			strings.ToUpper("Post"),
+			"/v1/configs/{config-id}/discovery",
+			c.PostDiscoveryByConfigId,
+		},
+		"PostForgetSuppressedAssetsByConfigId": Route{
+			strings.ToUpper("Post"),
+			"/v1/configs/{config-id}/suppressed-assets/forget",
+			c.PostForgetSuppressedAssetsByConfigId,
+		},
+		"PostMappingReconciliationByConfigId": Route{
+			strings.ToUpper("Post"),
+			"/v1/configs/{config-id}/reconcile",
+			c.PostMappingReconciliationByConfigId,
+		},
+		"PostRecreateSuppressedAssetsByConfigId": Route{
+			strings.ToUpper("Post"),
+			"/v1/configs/{config-id}/suppressed-assets/recreate",
+			c.PostRecreateSuppressedAssetsByConfigId,
+		},
+		"PostSyncJobByConfigId": Route{
+			strings.ToUpper("Post"),
+			"/v1/configs/{config-id}/sync",
+			c.PostSyncJobByConfigId,
+		},
+		"PostValueRefreshByConfigId": Route{
+			strings.ToUpper("Post"),
+			"/v1/configs/{config-id}/refresh",
+			c.PostValueRefreshByConfigId,
+		},
+		"PutAttributeTransformationByConfigId": Route{
+			strings.ToUpper("Put"),
+			"/v1/configs/{config-id}/transformations",
+			c.PutAttributeTransformationByConfigId,
+		},
 		"PutConfigurationById": Route{
 			strings.ToUpper("Put"),
 			"/v1/configs/{config-id}",
@@ -101,6 +177,69 @@ func (c *ConfigurationAPIController) DeleteConfigurationById(w http.ResponseWrit
 	EncodeJSONResponse(result.Body, &result.Code, w)
 }
 
+// GetAssetPreviewByConfigId - Preview asset changes
+func (c *ConfigurationAPIController) GetAssetPreviewByConfigId(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	configIdParam, err := parseNumericParameter[int64](
+		params["config-id"],
+		WithRequire[int64](parseInt64),
+	)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	result, err := c.service.GetAssetPreviewByConfigId(r.Context(), configIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// GetAttributeTransformationsByConfigId - List attribute transformations
+func (c *ConfigurationAPIController) GetAttributeTransformationsByConfigId(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	configIdParam, err := parseNumericParameter[int64](
+		params["config-id"],
+		WithRequire[int64](parseInt64),
+	)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	result, err := c.service.GetAttributeTransformationsByConfigId(r.Context(), configIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// GetBufferStatusByConfigId - Get buffer status
+func (c *ConfigurationAPIController) GetBufferStatusByConfigId(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	configIdParam, err := parseNumericParameter[int64](
+		params["config-id"],
+		WithRequire[int64](parseInt64),
+	)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	result, err := c.service.GetBufferStatusByConfigId(r.Context(), configIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
 // GetConfigurationById - Get configuration
 func (c *ConfigurationAPIController) GetConfigurationById(w http.ResponseWriter, r *http.Request) {
 	params := mux.Vars(r)
@@ -134,6 +273,98 @@ func (c *ConfigurationAPIController) GetConfigurations(w http.ResponseWriter, r
 	EncodeJSONResponse(result.Body, &result.Code, w)
 }
 
+// GetSuppressedAssetsByConfigId - List assets deleted in Eliona
+func (c *ConfigurationAPIController) GetSuppressedAssetsByConfigId(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	configIdParam, err := parseNumericParameter[int64](
+		params["config-id"],
+		WithRequire[int64](parseInt64),
+	)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	result, err := c.service.GetSuppressedAssetsByConfigId(r.Context(), configIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// GetSyncJobById - Get synchronization job
+func (c *ConfigurationAPIController) GetSyncJobById(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	configIdParam, err := parseNumericParameter[int64](
+		params["config-id"],
+		WithRequire[int64](parseInt64),
+	)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	jobIdParam, err := parseNumericParameter[int64](
+		params["job-id"],
+		WithRequire[int64](parseInt64),
+	)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	result, err := c.service.GetSyncJobById(r.Context(), configIdParam, jobIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// GetSystemAvailabilityByConfigId - Get SysAP availability
+func (c *ConfigurationAPIController) GetSystemAvailabilityByConfigId(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	query := r.URL.Query()
+	configIdParam, err := parseNumericParameter[int64](
+		params["config-id"],
+		WithRequire[int64](parseInt64),
+	)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	var fromParam time.Time
+	if query.Has("from") {
+		param, err := parseTime(query.Get("from"))
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+			return
+		}
+
+		fromParam = param
+	}
+	var toParam time.Time
+	if query.Has("to") {
+		param, err := parseTime(query.Get("to"))
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+			return
+		}
+
+		toParam = param
+	}
+	result, err := c.service.GetSystemAvailabilityByConfigId(r.Context(), configIdParam, fromParam, toParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
 // PostConfiguration - Creates a configuration
 func (c *ConfigurationAPIController) PostConfiguration(w http.ResponseWriter, r *http.Request) {
 	configurationParam := Configuration{}
@@ -161,6 +392,267 @@ func (c *ConfigurationAPIController) PostConfiguration(w http.ResponseWriter, r
 	EncodeJSONResponse(result.Body, &result.Code, w)
 }
 
+// PostConfigurationExport - Export configurations
+func (c *ConfigurationAPIController) PostConfigurationExport(w http.ResponseWriter, r *http.Request) {
+	configurationExportRequestParam := ConfigurationExportRequest{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&configurationExportRequestParam); err != nil && !errors.Is(err, io.EOF) {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertConfigurationExportRequestRequired(configurationExportRequestParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	if err := AssertConfigurationExportRequestConstraints(configurationExportRequestParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.PostConfigurationExport(r.Context(), configurationExportRequestParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// PostConfigurationImport - Import configurations
+func (c *ConfigurationAPIController) PostConfigurationImport(w http.ResponseWriter, r *http.Request) {
+	configurationImportRequestParam := ConfigurationImportRequest{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&configurationImportRequestParam); err != nil && !errors.Is(err, io.EOF) {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertConfigurationImportRequestRequired(configurationImportRequestParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	if err := AssertConfigurationImportRequestConstraints(configurationImportRequestParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.PostConfigurationImport(r.Context(), configurationImportRequestParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// PostDiscoveryByConfigId - Trigger discovery
+func (c *ConfigurationAPIController) PostDiscoveryByConfigId(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	configIdParam, err := parseNumericParameter[int64](
+		params["config-id"],
+		WithRequire[int64](parseInt64),
+	)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	result, err := c.service.PostDiscoveryByConfigId(r.Context(), configIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// PostForgetSuppressedAssetsByConfigId - Forget assets deleted in Eliona
+func (c *ConfigurationAPIController) PostForgetSuppressedAssetsByConfigId(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	configIdParam, err := parseNumericParameter[int64](
+		params["config-id"],
+		WithRequire[int64](parseInt64),
+	)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	suppressedAssetSelectionParam := SuppressedAssetSelection{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&suppressedAssetSelectionParam); err != nil && !errors.Is(err, io.EOF) {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertSuppressedAssetSelectionRequired(suppressedAssetSelectionParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	if err := AssertSuppressedAssetSelectionConstraints(suppressedAssetSelectionParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.PostForgetSuppressedAssetsByConfigId(r.Context(), configIdParam, suppressedAssetSelectionParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// PostMappingReconciliationByConfigId - Reconcile datapoint mappings
+func (c *ConfigurationAPIController) PostMappingReconciliationByConfigId(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	configIdParam, err := parseNumericParameter[int64](
+		params["config-id"],
+		WithRequire[int64](parseInt64),
+	)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	result, err := c.service.PostMappingReconciliationByConfigId(r.Context(), configIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// PostRecreateSuppressedAssetsByConfigId - Re-create assets deleted in Eliona
+func (c *ConfigurationAPIController) PostRecreateSuppressedAssetsByConfigId(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	configIdParam, err := parseNumericParameter[int64](
+		params["config-id"],
+		WithRequire[int64](parseInt64),
+	)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	suppressedAssetSelectionParam := SuppressedAssetSelection{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&suppressedAssetSelectionParam); err != nil && !errors.Is(err, io.EOF) {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertSuppressedAssetSelectionRequired(suppressedAssetSelectionParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	if err := AssertSuppressedAssetSelectionConstraints(suppressedAssetSelectionParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.PostRecreateSuppressedAssetsByConfigId(r.Context(), configIdParam, suppressedAssetSelectionParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// PostSyncJobByConfigId - Start synchronization
+func (c *ConfigurationAPIController) PostSyncJobByConfigId(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	configIdParam, err := parseNumericParameter[int64](
+		params["config-id"],
+		WithRequire[int64](parseInt64),
+	)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	syncRequestParam := SyncRequest{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&syncRequestParam); err != nil && !errors.Is(err, io.EOF) {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertSyncRequestRequired(syncRequestParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	if err := AssertSyncRequestConstraints(syncRequestParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.PostSyncJobByConfigId(r.Context(), configIdParam, syncRequestParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// PostValueRefreshByConfigId - Trigger value refresh
+func (c *ConfigurationAPIController) PostValueRefreshByConfigId(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	configIdParam, err := parseNumericParameter[int64](
+		params["config-id"],
+		WithRequire[int64](parseInt64),
+	)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	result, err := c.service.PostValueRefreshByConfigId(r.Context(), configIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// PutAttributeTransformationByConfigId - Set the transformation of an attribute
+func (c *ConfigurationAPIController) PutAttributeTransformationByConfigId(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	configIdParam, err := parseNumericParameter[int64](
+		params["config-id"],
+		WithRequire[int64](parseInt64),
+	)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	attributeTransformationParam := AttributeTransformation{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&attributeTransformationParam); err != nil && !errors.Is(err, io.EOF) {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertAttributeTransformationRequired(attributeTransformationParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	if err := AssertAttributeTransformationConstraints(attributeTransformationParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.PutAttributeTransformationByConfigId(r.Context(), configIdParam, attributeTransformationParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
 // PutConfigurationById - Updates a configuration
 func (c *ConfigurationAPIController) PutConfigurationById(w http.ResponseWriter, r *http.Request) {
 	params := mux.Vars(r)
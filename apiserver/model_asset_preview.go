@@ -0,0 +1,68 @@
+/*
+ * ABB Free@Home App API
+ *
+ * API to access and configure the ABB Free@Home App
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+// AssetPreview - Changes a discovery would make to the assets of a project.
+type AssetPreview struct {
+
+	// ID of the Eliona project
+	ProjectId string `json:"projectId"`
+
+	// Assets that would be created
+	Created []AssetPreviewChange `json:"created"`
+
+	// Existing assets that would get a new name
+	Renamed []AssetPreviewChange `json:"renamed"`
+
+	// Existing assets that would get a new locational parent
+	Moved []AssetPreviewChange `json:"moved"`
+
+	// Assets that are no longer reported by ABB
+	Orphaned []AssetPreviewChange `json:"orphaned"`
+}
+
+// AssertAssetPreviewRequired checks if the required fields are not zero-ed
+func AssertAssetPreviewRequired(obj AssetPreview) error {
+	elements := map[string]interface{}{
+		"projectId": obj.ProjectId,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	for _, el := range obj.Created {
+		if err := AssertAssetPreviewChangeRequired(el); err != nil {
+			return err
+		}
+	}
+	for _, el := range obj.Renamed {
+		if err := AssertAssetPreviewChangeRequired(el); err != nil {
+			return err
+		}
+	}
+	for _, el := range obj.Moved {
+		if err := AssertAssetPreviewChangeRequired(el); err != nil {
+			return err
+		}
+	}
+	for _, el := range obj.Orphaned {
+		if err := AssertAssetPreviewChangeRequired(el); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AssertAssetPreviewConstraints checks if the values respects the defined constraints
+func AssertAssetPreviewConstraints(obj AssetPreview) error {
+	return nil
+}
@@ -0,0 +1,49 @@
+/*
+ * ABB Free@Home App API
+ *
+ * API to access and configure the ABB Free@Home App
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+// AssetPreviewChange - A single asset affected by a discovery.
+type AssetPreviewChange struct {
+
+	// Global asset identifier without the configuration prefix
+	Gai string `json:"gai"`
+
+	// Asset type
+	AssetType string `json:"assetType"`
+
+	// Name the asset would get
+	Name string `json:"name"`
+
+	// Previous name or locational parent (GAI). Only set for renamed and moved assets.
+	From *string `json:"from,omitempty"`
+
+	// New name or locational parent (GAI). Only set for renamed and moved assets.
+	To *string `json:"to,omitempty"`
+}
+
+// AssertAssetPreviewChangeRequired checks if the required fields are not zero-ed
+func AssertAssetPreviewChangeRequired(obj AssetPreviewChange) error {
+	elements := map[string]interface{}{
+		"gai":       obj.Gai,
+		"assetType": obj.AssetType,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	return nil
+}
+
+// AssertAssetPreviewChangeConstraints checks if the values respects the defined constraints
+func AssertAssetPreviewChangeConstraints(obj AssetPreviewChange) error {
+	return nil
+}
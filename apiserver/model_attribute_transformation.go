@@ -0,0 +1,58 @@
+/*
+ * ABB Free@Home App API
+ *
+ * API to access and configure the ABB Free@Home App
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+// AttributeTransformation - Transformation of the values linked to an asset attribute.
+type AttributeTransformation struct {
+
+	// ID of the Eliona asset
+	AssetId int32 `json:"assetId"`
+
+	// Global asset identifier without the configuration prefix
+	Gai string `json:"gai,omitempty"`
+
+	// Subtype of the attribute
+	Subtype string `json:"subtype"`
+
+	// Name of the attribute
+	Attribute string `json:"attribute"`
+
+	// ABB datapoint the attribute is linked to
+	Datapoint string `json:"datapoint,omitempty"`
+
+	// Steps applied in order. Empty to write the values unchanged.
+	Rules []TransformationRule `json:"rules"`
+}
+
+// AssertAttributeTransformationRequired checks if the required fields are not zero-ed
+func AssertAttributeTransformationRequired(obj AttributeTransformation) error {
+	elements := map[string]interface{}{
+		"assetId":   obj.AssetId,
+		"subtype":   obj.Subtype,
+		"attribute": obj.Attribute,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	for _, el := range obj.Rules {
+		if err := AssertTransformationRuleRequired(el); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AssertAttributeTransformationConstraints checks if the values respects the defined constraints
+func AssertAttributeTransformationConstraints(obj AttributeTransformation) error {
+	return nil
+}
@@ -0,0 +1,51 @@
+/*
+ * ABB Free@Home App API
+ *
+ * API to access and configure the ABB Free@Home App
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+import (
+	"time"
+)
+
+// BufferStatus - Backlog of updates that could not be delivered to Eliona yet.
+type BufferStatus struct {
+
+	// ID of the configuration
+	ConfigId int64 `json:"configId"`
+
+	// Number of buffered updates
+	Depth int64 `json:"depth"`
+
+	// Number of assets with buffered updates
+	Assets int64 `json:"assets"`
+
+	// ABB timestamp of the oldest buffered update. Null if the buffer is empty.
+	OldestValueTime *time.Time `json:"oldestValueTime,omitempty"`
+}
+
+// AssertBufferStatusRequired checks if the required fields are not zero-ed
+func AssertBufferStatusRequired(obj BufferStatus) error {
+	elements := map[string]interface{}{
+		"configId": obj.ConfigId,
+		"depth":    obj.Depth,
+		"assets":   obj.Assets,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	return nil
+}
+
+// AssertBufferStatusConstraints checks if the values respects the defined constraints
+func AssertBufferStatusConstraints(obj BufferStatus) error {
+	return nil
+}
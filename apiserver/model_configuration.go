@@ -55,12 +55,78 @@ type Configuration struct {
 	// Flag to enable or disable fetching from this API
 	Enable *bool `json:"enable,omitempty"`
 
-	// Interval in seconds for collecting data from API
+	// Interval in seconds for refreshing the values of all devices
 	RefreshInterval int32 `json:"refreshInterval,omitempty"`
 
+	// Cron expression for refreshing the values, overrides refreshInterval. Empty if not used.
+	RefreshCron *string `json:"refreshCron,omitempty"`
+
+	// Interval in seconds for discovering devices and locations and creating their assets
+	DiscoveryInterval *int32 `json:"discoveryInterval,omitempty"`
+
+	// Cron expression for the discovery, overrides discoveryInterval. Empty if not used.
+	DiscoveryCron *string `json:"discoveryCron,omitempty"`
+
+	// Daily time window like `22:00-06:00` (UTC) in which no scheduled discovery is started. Empty if not used.
+	DiscoveryQuietHours *string `json:"discoveryQuietHours,omitempty"`
+
 	// Timeout in seconds
 	RequestTimeout *int32 `json:"requestTimeout,omitempty"`
 
+	// Seconds the value refresh may be late according to its schedule (e.g. during an outage of the app or the ABB system), after which the values changed in the meantime are written to Eliona with their original ABB timestamps. Set to 0 to disable.
+	BackfillThreshold *int32 `json:"backfillThreshold,omitempty"`
+
+	// Maximum number of updates buffered while Eliona is unavailable. When exceeded, the oldest updates are dropped.
+	BufferSize *int32 `json:"bufferSize,omitempty"`
+
+	// Keep only the latest buffered value of each asset attribute instead of all values.
+	BufferCompaction *bool `json:"bufferCompaction,omitempty"`
+
+	// Seconds a device or channel must be missing at ABB before its asset is retired.
+	OrphanGracePeriod *int32 `json:"orphanGracePeriod,omitempty"`
+
+	// What happens to retired assets. `keep` leaves them in Eliona and only notifies the user, `delete` removes them from Eliona.
+	OrphanPolicy *string `json:"orphanPolicy,omitempty"`
+
+	// Name of the root asset under which the assets of this configuration are created.
+	RootAssetName *string `json:"rootAssetName,omitempty"`
+
+	// Battery level in percent below which an alert is raised for a device.
+	LowBatteryThreshold *int32 `json:"lowBatteryThreshold,omitempty"`
+
+	// Signal quality in percent below which an alert is raised for a wireless device.
+	WeakSignalThreshold *int32 `json:"weakSignalThreshold,omitempty"`
+
+	// Percentage points above the threshold the value must recover to before the alert is cleared, so that alerts do not flap.
+	AlertHysteresis *int32 `json:"alertHysteresis,omitempty"`
+
+	// How device alerts are raised. `notification` notifies the user, `alarm` creates an Eliona alarm rule on the device asset, `off` disables alerts.
+	AlertMode *string `json:"alertMode,omitempty"`
+
+	// Seconds a SysAP must be offline before the user is notified. 0 disables the notification.
+	OfflineNotificationThreshold *int32 `json:"offlineNotificationThreshold,omitempty"`
+
+	// Notify the user when a door entry ringing sensor reports a ring.
+	RingNotifications *bool `json:"ringNotifications,omitempty"`
+
+	// Preferred language of the asset names, if ABB translates them. Names set by the user on the SysAP are not translated.
+	Language *string `json:"language,omitempty"`
+
+	// Template for the names of device assets. Placeholders: {system}, {floor}, {room}, {device}, {serial}, {gai}.
+	DeviceNameTemplate *string `json:"deviceNameTemplate,omitempty"`
+
+	// Template for the names of channel assets. Placeholders: {system}, {floor}, {room}, {device}, {serial}, {channel}, {function}, {gai}.
+	ChannelNameTemplate *string `json:"channelNameTemplate,omitempty"`
+
+	// Template for the descriptions of device assets. Same placeholders as deviceNameTemplate.
+	DeviceDescriptionTemplate *string `json:"deviceDescriptionTemplate,omitempty"`
+
+	// Template for the descriptions of channel assets. Same placeholders as channelNameTemplate.
+	ChannelDescriptionTemplate *string `json:"channelDescriptionTemplate,omitempty"`
+
+	// Prefix making the Eliona asset identifiers of this configuration unique, so that several configurations can share a project. Empty for the configuration that existed before the prefixes were introduced.
+	GaiScope *string `json:"gaiScope,omitempty"`
+
 	// Array of rules combined by logical OR
 	AssetFilter [][]FilterRule `json:"assetFilter,omitempty"`
 
@@ -70,6 +136,9 @@ type Configuration struct {
 	// List of Eliona project ids for which this device should collect data. For each project id all smart devices are automatically created as an asset in Eliona. The mapping between Eliona is stored as an asset mapping in the KentixONE app.
 	ProjectIDs *[]string `json:"projectIDs,omitempty"`
 
+	// Eliona project ids for individual ABB systems, keyed by the system's dtId. Systems not listed here use projectIDs.
+	SystemProjectIDs *map[string][]string `json:"systemProjectIDs,omitempty"`
+
 	// ID of the last Eliona user who created or updated the configuration
 	UserId *string `json:"userId,omitempty"`
 }
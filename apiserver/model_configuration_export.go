@@ -0,0 +1,50 @@
+/*
+ * ABB Free@Home App API
+ *
+ * API to access and configure the ABB Free@Home App
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+import (
+	"time"
+)
+
+// ConfigurationExport - Configurations together with their asset and datapoint mappings.
+type ConfigurationExport struct {
+
+	// Version of the export format
+	Version int32 `json:"version"`
+
+	// When the export was created
+	ExportedAt time.Time `json:"exportedAt"`
+
+	Configurations []ExportedConfiguration `json:"configurations"`
+}
+
+// AssertConfigurationExportRequired checks if the required fields are not zero-ed
+func AssertConfigurationExportRequired(obj ConfigurationExport) error {
+	elements := map[string]interface{}{
+		"version": obj.Version,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	for _, el := range obj.Configurations {
+		if err := AssertExportedConfigurationRequired(el); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AssertConfigurationExportConstraints checks if the values respects the defined constraints
+func AssertConfigurationExportConstraints(obj ConfigurationExport) error {
+	return nil
+}
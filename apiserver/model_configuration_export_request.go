@@ -0,0 +1,30 @@
+/*
+ * ABB Free@Home App API
+ *
+ * API to access and configure the ABB Free@Home App
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+// ConfigurationExportRequest - Configurations to export.
+type ConfigurationExportRequest struct {
+
+	// IDs of the configurations to export. All configurations if empty.
+	ConfigIds []int64 `json:"configIds,omitempty"`
+
+	// If set, the secrets (API key, client secret, tokens and password) are encrypted with this passphrase. Otherwise they are exported in plain text.
+	Passphrase *string `json:"passphrase,omitempty"`
+}
+
+// AssertConfigurationExportRequestRequired checks if the required fields are not zero-ed
+func AssertConfigurationExportRequestRequired(obj ConfigurationExportRequest) error {
+	return nil
+}
+
+// AssertConfigurationExportRequestConstraints checks if the values respects the defined constraints
+func AssertConfigurationExportRequestConstraints(obj ConfigurationExportRequest) error {
+	return nil
+}
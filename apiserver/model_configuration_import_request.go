@@ -0,0 +1,31 @@
+/*
+ * ABB Free@Home App API
+ *
+ * API to access and configure the ABB Free@Home App
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+// ConfigurationImportRequest - Export to import into this environment.
+type ConfigurationImportRequest struct {
+	Export ConfigurationExport `json:"export"`
+
+	// Passphrase the secrets were encrypted with. Only needed if they are encrypted.
+	Passphrase *string `json:"passphrase,omitempty"`
+}
+
+// AssertConfigurationImportRequestRequired checks if the required fields are not zero-ed
+func AssertConfigurationImportRequestRequired(obj ConfigurationImportRequest) error {
+	if err := AssertConfigurationExportRequired(obj.Export); err != nil {
+		return err
+	}
+	return nil
+}
+
+// AssertConfigurationImportRequestConstraints checks if the values respects the defined constraints
+func AssertConfigurationImportRequestConstraints(obj ConfigurationImportRequest) error {
+	return nil
+}
@@ -0,0 +1,48 @@
+/*
+ * ABB Free@Home App API
+ *
+ * API to access and configure the ABB Free@Home App
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+// ConfigurationImportResult - Outcome of importing a configuration.
+type ConfigurationImportResult struct {
+
+	// ID the configuration got in this environment
+	ConfigId int64 `json:"configId"`
+
+	// Prefix of the Eliona asset identifiers, taken over from the export
+	GaiScope string `json:"gaiScope"`
+
+	// Number of assets linked to existing Eliona assets with the same GAI
+	AssetsLinked int32 `json:"assetsLinked"`
+
+	// Number of assets not found in Eliona. They are created by the next discovery.
+	AssetsMissing int32 `json:"assetsMissing"`
+
+	// Number of datapoint mappings imported
+	Datapoints int32 `json:"datapoints"`
+}
+
+// AssertConfigurationImportResultRequired checks if the required fields are not zero-ed
+func AssertConfigurationImportResultRequired(obj ConfigurationImportResult) error {
+	elements := map[string]interface{}{
+		"configId": obj.ConfigId,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	return nil
+}
+
+// AssertConfigurationImportResultConstraints checks if the values respects the defined constraints
+func AssertConfigurationImportResultConstraints(obj ConfigurationImportResult) error {
+	return nil
+}
@@ -0,0 +1,74 @@
+/*
+ * ABB Free@Home App API
+ *
+ * API to access and configure the ABB Free@Home App
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+import (
+	"time"
+)
+
+// ExportedAsset - Mapping of an Eliona asset, identified by its GAI.
+type ExportedAsset struct {
+
+	// ID of the Eliona project
+	ProjectId string `json:"projectId"`
+
+	// Global asset identifier without the configuration prefix
+	Gai string `json:"gai"`
+
+	// Asset type
+	AssetType string `json:"assetType"`
+
+	// ID of the entity at ABB
+	ProviderId string `json:"providerId"`
+
+	// Name of the asset. Empty for assets stored by older app versions.
+	Name *string `json:"name,omitempty"`
+
+	// GAI of the locational parent
+	LocationalParent *string `json:"locationalParent,omitempty"`
+
+	// Since when the entity is no longer reported by ABB
+	OrphanedAt *time.Time `json:"orphanedAt,omitempty"`
+
+	// When the asset was retired
+	RetiredAt *time.Time `json:"retiredAt,omitempty"`
+
+	// When the asset deleted in Eliona was forgotten
+	ForgottenAt *time.Time `json:"forgottenAt,omitempty"`
+
+	Datapoints []ExportedDatapoint `json:"datapoints"`
+}
+
+// AssertExportedAssetRequired checks if the required fields are not zero-ed
+func AssertExportedAssetRequired(obj ExportedAsset) error {
+	elements := map[string]interface{}{
+		"projectId":  obj.ProjectId,
+		"gai":        obj.Gai,
+		"assetType":  obj.AssetType,
+		"providerId": obj.ProviderId,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	for _, el := range obj.Datapoints {
+		if err := AssertExportedDatapointRequired(el); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AssertExportedAssetConstraints checks if the values respects the defined constraints
+func AssertExportedAssetConstraints(obj ExportedAsset) error {
+	return nil
+}
@@ -0,0 +1,38 @@
+/*
+ * ABB Free@Home App API
+ *
+ * API to access and configure the ABB Free@Home App
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+// ExportedConfiguration - A configuration with its mappings.
+type ExportedConfiguration struct {
+	Configuration Configuration `json:"configuration"`
+
+	// Secrets of the configuration encrypted with the export passphrase. Empty if the secrets are exported in plain text.
+	EncryptedSecrets *string `json:"encryptedSecrets,omitempty"`
+
+	Assets []ExportedAsset `json:"assets"`
+}
+
+// AssertExportedConfigurationRequired checks if the required fields are not zero-ed
+func AssertExportedConfigurationRequired(obj ExportedConfiguration) error {
+	if err := AssertConfigurationRequired(obj.Configuration); err != nil {
+		return err
+	}
+	for _, el := range obj.Assets {
+		if err := AssertExportedAssetRequired(el); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AssertExportedConfigurationConstraints checks if the values respects the defined constraints
+func AssertExportedConfigurationConstraints(obj ExportedConfiguration) error {
+	return nil
+}
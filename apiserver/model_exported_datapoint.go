@@ -0,0 +1,59 @@
+/*
+ * ABB Free@Home App API
+ *
+ * API to access and configure the ABB Free@Home App
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+// ExportedDatapoint - Mapping of an ABB datapoint to the attributes of its asset.
+type ExportedDatapoint struct {
+	SystemId string `json:"systemId"`
+
+	DeviceId string `json:"deviceId"`
+
+	ChannelId string `json:"channelId"`
+
+	// ABB datapoint, e.g. `odp0000`
+	Datapoint string `json:"datapoint"`
+
+	Function string `json:"function"`
+
+	IsInput bool `json:"isInput"`
+
+	// KNX datapoint type of outputs
+	Dpt *string `json:"dpt,omitempty"`
+
+	Attributes []ExportedDatapointAttribute `json:"attributes"`
+}
+
+// AssertExportedDatapointRequired checks if the required fields are not zero-ed
+func AssertExportedDatapointRequired(obj ExportedDatapoint) error {
+	elements := map[string]interface{}{
+		"systemId":  obj.SystemId,
+		"deviceId":  obj.DeviceId,
+		"channelId": obj.ChannelId,
+		"datapoint": obj.Datapoint,
+		"function":  obj.Function,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	for _, el := range obj.Attributes {
+		if err := AssertExportedDatapointAttributeRequired(el); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AssertExportedDatapointConstraints checks if the values respects the defined constraints
+func AssertExportedDatapointConstraints(obj ExportedDatapoint) error {
+	return nil
+}
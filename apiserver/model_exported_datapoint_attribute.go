@@ -0,0 +1,45 @@
+/*
+ * ABB Free@Home App API
+ *
+ * API to access and configure the ABB Free@Home App
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+// ExportedDatapointAttribute - Eliona attribute a datapoint is linked to.
+type ExportedDatapointAttribute struct {
+	Subtype string `json:"subtype"`
+
+	AttributeName string `json:"attributeName"`
+
+	// Transformation rules of the link, if any
+	Transformation []TransformationRule `json:"transformation,omitempty"`
+}
+
+// AssertExportedDatapointAttributeRequired checks if the required fields are not zero-ed
+func AssertExportedDatapointAttributeRequired(obj ExportedDatapointAttribute) error {
+	elements := map[string]interface{}{
+		"subtype":       obj.Subtype,
+		"attributeName": obj.AttributeName,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	for _, el := range obj.Transformation {
+		if err := AssertTransformationRuleRequired(el); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AssertExportedDatapointAttributeConstraints checks if the values respects the defined constraints
+func AssertExportedDatapointAttributeConstraints(obj ExportedDatapointAttribute) error {
+	return nil
+}
@@ -0,0 +1,40 @@
+/*
+ * ABB Free@Home App API
+ *
+ * API to access and configure the ABB Free@Home App
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+// MappingReconciliation - Result of a datapoint mapping reconciliation.
+type MappingReconciliation struct {
+
+	// ID of the configuration
+	ConfigId int64 `json:"configId"`
+
+	// Changes made to the mappings. Empty if everything was up to date.
+	Changes []string `json:"changes"`
+}
+
+// AssertMappingReconciliationRequired checks if the required fields are not zero-ed
+func AssertMappingReconciliationRequired(obj MappingReconciliation) error {
+	elements := map[string]interface{}{
+		"configId": obj.ConfigId,
+		"changes":  obj.Changes,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	return nil
+}
+
+// AssertMappingReconciliationConstraints checks if the values respects the defined constraints
+func AssertMappingReconciliationConstraints(obj MappingReconciliation) error {
+	return nil
+}
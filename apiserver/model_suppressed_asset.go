@@ -0,0 +1,57 @@
+/*
+ * ABB Free@Home App API
+ *
+ * API to access and configure the ABB Free@Home App
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+// SuppressedAsset - Asset deleted in Eliona whose entity is still reported by ABB. The app does not create it again on its own.
+type SuppressedAsset struct {
+
+	// ID of the deleted Eliona asset
+	AssetId int32 `json:"assetId"`
+
+	// ID of the Eliona project
+	ProjectId string `json:"projectId"`
+
+	// Global asset identifier without the configuration prefix
+	Gai string `json:"gai"`
+
+	// Asset type
+	AssetType string `json:"assetType"`
+
+	// Name of the asset
+	Name string `json:"name"`
+
+	// Number of datapoints linked to the asset
+	Datapoints int32 `json:"datapoints"`
+
+	// ID of the Eliona asset created anew. Only set in the response to a re-creation.
+	RecreatedAssetId *int32 `json:"recreatedAssetId,omitempty"`
+}
+
+// AssertSuppressedAssetRequired checks if the required fields are not zero-ed
+func AssertSuppressedAssetRequired(obj SuppressedAsset) error {
+	elements := map[string]interface{}{
+		"assetId":   obj.AssetId,
+		"projectId": obj.ProjectId,
+		"gai":       obj.Gai,
+		"assetType": obj.AssetType,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	return nil
+}
+
+// AssertSuppressedAssetConstraints checks if the values respects the defined constraints
+func AssertSuppressedAssetConstraints(obj SuppressedAsset) error {
+	return nil
+}
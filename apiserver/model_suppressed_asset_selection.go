@@ -0,0 +1,27 @@
+/*
+ * ABB Free@Home App API
+ *
+ * API to access and configure the ABB Free@Home App
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+// SuppressedAssetSelection - Suppressed assets to act on.
+type SuppressedAssetSelection struct {
+
+	// IDs of the deleted Eliona assets. All suppressed assets of the configuration if empty.
+	AssetIds []int32 `json:"assetIds,omitempty"`
+}
+
+// AssertSuppressedAssetSelectionRequired checks if the required fields are not zero-ed
+func AssertSuppressedAssetSelectionRequired(obj SuppressedAssetSelection) error {
+	return nil
+}
+
+// AssertSuppressedAssetSelectionConstraints checks if the values respects the defined constraints
+func AssertSuppressedAssetSelectionConstraints(obj SuppressedAssetSelection) error {
+	return nil
+}
@@ -0,0 +1,94 @@
+/*
+ * ABB Free@Home App API
+ *
+ * API to access and configure the ABB Free@Home App
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+import (
+	"time"
+)
+
+// SyncJob - Synchronization requested through the API, with its progress and results.
+type SyncJob struct {
+
+	// ID of the job
+	Id int64 `json:"id"`
+
+	// ID of the configuration
+	ConfigId int64 `json:"configId"`
+
+	// What is synchronized: `discovery`, `values` or `reconcile`
+	Mode string `json:"mode"`
+
+	// `pending`, `running`, `finished` or `failed`
+	Status string `json:"status"`
+
+	// Progress in percent
+	Progress int32 `json:"progress"`
+
+	// Step currently running
+	Step string `json:"step"`
+
+	// Number of assets created
+	AssetsCreated int32 `json:"assetsCreated"`
+
+	// Number of existing assets renamed or moved
+	AssetsUpdated int32 `json:"assetsUpdated"`
+
+	// Number of assets newly marked as orphaned
+	AssetsOrphaned int32 `json:"assetsOrphaned"`
+
+	// Number of orphaned assets retired
+	AssetsRetired int32 `json:"assetsRetired"`
+
+	// Changes made by the mapping reconciliation
+	Changes []string `json:"changes"`
+
+	// Errors that occurred
+	Errors []string `json:"errors"`
+
+	// When the job was requested
+	CreatedAt time.Time `json:"createdAt"`
+
+	// When the job started. Null if pending.
+	StartedAt *time.Time `json:"startedAt,omitempty"`
+
+	// When the job finished. Null if not finished yet.
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+}
+
+// AssertSyncJobRequired checks if the required fields are not zero-ed
+func AssertSyncJobRequired(obj SyncJob) error {
+	elements := map[string]interface{}{
+		"id":             obj.Id,
+		"configId":       obj.ConfigId,
+		"mode":           obj.Mode,
+		"status":         obj.Status,
+		"progress":       obj.Progress,
+		"step":           obj.Step,
+		"assetsCreated":  obj.AssetsCreated,
+		"assetsUpdated":  obj.AssetsUpdated,
+		"assetsOrphaned": obj.AssetsOrphaned,
+		"assetsRetired":  obj.AssetsRetired,
+		"changes":        obj.Changes,
+		"errors":         obj.Errors,
+		"createdAt":      obj.CreatedAt,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	return nil
+}
+
+// AssertSyncJobConstraints checks if the values respects the defined constraints
+func AssertSyncJobConstraints(obj SyncJob) error {
+	return nil
+}
@@ -0,0 +1,36 @@
+/*
+ * ABB Free@Home App API
+ *
+ * API to access and configure the ABB Free@Home App
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+// SyncRequest - Synchronization to run for a configuration.
+type SyncRequest struct {
+
+	// What to synchronize. `discovery` creates and updates the assets, `values` writes the current values, `reconcile` runs a discovery followed by a full mapping reconciliation.
+	Mode string `json:"mode"`
+}
+
+// AssertSyncRequestRequired checks if the required fields are not zero-ed
+func AssertSyncRequestRequired(obj SyncRequest) error {
+	elements := map[string]interface{}{
+		"mode": obj.Mode,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	return nil
+}
+
+// AssertSyncRequestConstraints checks if the values respects the defined constraints
+func AssertSyncRequestConstraints(obj SyncRequest) error {
+	return nil
+}
@@ -0,0 +1,77 @@
+/*
+ * ABB Free@Home App API
+ *
+ * API to access and configure the ABB Free@Home App
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+import (
+	"time"
+)
+
+// SystemAvailability - Uptime and outage statistics of a SysAP for a period.
+type SystemAvailability struct {
+
+	// ID of the SysAP at ABB
+	SystemId string `json:"systemId"`
+
+	// Start of the period
+	From time.Time `json:"from"`
+
+	// End of the period
+	To time.Time `json:"to"`
+
+	// Seconds the SysAP was connected during the period
+	ConnectedSeconds int64 `json:"connectedSeconds"`
+
+	// Seconds the SysAP was disconnected during the period
+	DisconnectedSeconds int64 `json:"disconnectedSeconds"`
+
+	// Seconds of the period before the first recorded connection state
+	UnknownSeconds int64 `json:"unknownSeconds"`
+
+	// Share of the known time the SysAP was connected, from 0 to 1. Null if no state is known for the period.
+	Availability *float64 `json:"availability,omitempty"`
+
+	// Number of outages overlapping the period
+	Outages int64 `json:"outages"`
+
+	// Duration of the longest outage within the period in seconds
+	LongestOutageSeconds int64 `json:"longestOutageSeconds"`
+
+	// Current connection state. Null if unknown.
+	Connected *bool `json:"connected,omitempty"`
+
+	// When the current connection state was entered. Null if unknown.
+	ChangedAt *time.Time `json:"changedAt,omitempty"`
+}
+
+// AssertSystemAvailabilityRequired checks if the required fields are not zero-ed
+func AssertSystemAvailabilityRequired(obj SystemAvailability) error {
+	elements := map[string]interface{}{
+		"systemId":             obj.SystemId,
+		"from":                 obj.From,
+		"to":                   obj.To,
+		"connectedSeconds":     obj.ConnectedSeconds,
+		"disconnectedSeconds":  obj.DisconnectedSeconds,
+		"unknownSeconds":       obj.UnknownSeconds,
+		"outages":              obj.Outages,
+		"longestOutageSeconds": obj.LongestOutageSeconds,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	return nil
+}
+
+// AssertSystemAvailabilityConstraints checks if the values respects the defined constraints
+func AssertSystemAvailabilityConstraints(obj SystemAvailability) error {
+	return nil
+}
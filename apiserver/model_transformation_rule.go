@@ -0,0 +1,54 @@
+/*
+ * ABB Free@Home App API
+ *
+ * API to access and configure the ABB Free@Home App
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+// TransformationRule - Step transforming a value on its way from ABB to Eliona. Writes from Eliona to ABB apply the steps in reverse.
+type TransformationRule struct {
+
+	// `scale` multiplies by factor and adds offset, `enum` maps values, `bits` extracts a bit field, `invert` inverts a boolean and `parse` parses a string.
+	Type string `json:"type"`
+
+	// Factor of `scale`. Defaults to 1.
+	Factor *float64 `json:"factor,omitempty"`
+
+	// Offset of `scale`, added after scaling.
+	Offset *float64 `json:"offset,omitempty"`
+
+	// Mapping of `enum` from the ABB value to the Eliona value.
+	Mapping map[string]interface{} `json:"mapping,omitempty"`
+
+	// First bit of `bits`, counted from the least significant bit.
+	Shift *int32 `json:"shift,omitempty"`
+
+	// Number of bits of `bits`. Defaults to 1.
+	Width *int32 `json:"width,omitempty"`
+
+	// Format parsed by `parse`: `int`, `float`, `hex`, `bool` or `string`.
+	Format *string `json:"format,omitempty"`
+}
+
+// AssertTransformationRuleRequired checks if the required fields are not zero-ed
+func AssertTransformationRuleRequired(obj TransformationRule) error {
+	elements := map[string]interface{}{
+		"type": obj.Type,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	return nil
+}
+
+// AssertTransformationRuleConstraints checks if the values respects the defined constraints
+func AssertTransformationRuleConstraints(obj TransformationRule) error {
+	return nil
+}
@@ -17,10 +17,18 @@ package apiservices
 
 import (
 	"abb-free-at-home/apiserver"
+	"abb-free-at-home/broker"
 	"abb-free-at-home/conf"
+	"abb-free-at-home/eliona"
+	"abb-free-at-home/model"
+	"abb-free-at-home/schedule"
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
+	"time"
+
+	"github.com/eliona-smart-building-assistant/go-utils/common"
 )
 
 // ConfigurationApiService is a service that implements the logic for the ConfigurationApiServicer
@@ -44,12 +52,37 @@ func (s *ConfigurationApiService) GetConfigurations(ctx context.Context) (apiser
 
 func (s *ConfigurationApiService) PostConfiguration(ctx context.Context, config apiserver.Configuration) (apiserver.ImplResponse, error) {
 	insertedConfig, err := conf.InsertConfig(ctx, config)
+	if errors.Is(err, conf.ErrBadRequest) {
+		return apiserver.ImplResponse{Code: http.StatusBadRequest}, nil
+	}
 	if err != nil {
 		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
 	}
 	return apiserver.Response(http.StatusCreated, insertedConfig), nil
 }
 
+func (s *ConfigurationApiService) PostConfigurationExport(ctx context.Context, request apiserver.ConfigurationExportRequest) (apiserver.ImplResponse, error) {
+	export, err := conf.ExportConfigs(ctx, request.ConfigIds, common.Val(request.Passphrase))
+	if errors.Is(err, conf.ErrBadRequest) {
+		return apiserver.ImplResponse{Code: http.StatusBadRequest}, nil
+	}
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	return apiserver.Response(http.StatusOK, export), nil
+}
+
+func (s *ConfigurationApiService) PostConfigurationImport(ctx context.Context, request apiserver.ConfigurationImportRequest) (apiserver.ImplResponse, error) {
+	results, err := conf.ImportConfigs(ctx, request.Export, common.Val(request.Passphrase), eliona.AssetIDsByGAI)
+	if errors.Is(err, conf.ErrBadRequest) {
+		return apiserver.ImplResponse{Code: http.StatusBadRequest}, nil
+	}
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	return apiserver.Response(http.StatusCreated, results), nil
+}
+
 func (s *ConfigurationApiService) GetConfigurationById(ctx context.Context, configId int64) (apiserver.ImplResponse, error) {
 	config, err := conf.GetConfig(ctx, configId)
 	if errors.Is(err, conf.ErrBadRequest) {
@@ -64,6 +97,9 @@ func (s *ConfigurationApiService) GetConfigurationById(ctx context.Context, conf
 func (s *ConfigurationApiService) PutConfigurationById(ctx context.Context, configId int64, config apiserver.Configuration) (apiserver.ImplResponse, error) {
 	config.Id = &configId
 	upsertedConfig, err := conf.UpsertConfig(ctx, config)
+	if errors.Is(err, conf.ErrBadRequest) {
+		return apiserver.ImplResponse{Code: http.StatusBadRequest}, nil
+	}
 	if err != nil {
 		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
 	}
@@ -80,3 +116,239 @@ func (s *ConfigurationApiService) DeleteConfigurationById(ctx context.Context, c
 	}
 	return apiserver.ImplResponse{Code: http.StatusNoContent}, nil
 }
+
+func (s *ConfigurationApiService) GetAssetPreviewByConfigId(ctx context.Context, configId int64) (apiserver.ImplResponse, error) {
+	config, err := conf.GetConfig(ctx, configId)
+	if errors.Is(err, conf.ErrBadRequest) {
+		return apiserver.ImplResponse{Code: http.StatusBadRequest}, nil
+	}
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	locations, err := broker.GetLocations(config)
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, fmt.Errorf("getting abb locations: %v", err)
+	}
+	systems, err := broker.GetSystems(config, locations)
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, fmt.Errorf("getting abb configuration: %v", err)
+	}
+	previews, err := eliona.PreviewAssets(*config, locations, systems)
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	return apiserver.Response(http.StatusOK, append([]apiserver.AssetPreview{}, previews...)), nil
+}
+
+func (s *ConfigurationApiService) GetBufferStatusByConfigId(ctx context.Context, configId int64) (apiserver.ImplResponse, error) {
+	if _, err := conf.GetConfig(ctx, configId); errors.Is(err, conf.ErrBadRequest) {
+		return apiserver.ImplResponse{Code: http.StatusBadRequest}, nil
+	} else if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	status, err := conf.GetBufferStatus(ctx, configId)
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	return apiserver.Response(http.StatusOK, status), nil
+}
+
+func (s *ConfigurationApiService) GetSystemAvailabilityByConfigId(ctx context.Context, configId int64, from time.Time, to time.Time) (apiserver.ImplResponse, error) {
+	config, err := conf.GetConfig(ctx, configId)
+	if errors.Is(err, conf.ErrBadRequest) {
+		return apiserver.ImplResponse{Code: http.StatusBadRequest}, nil
+	}
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	if to.IsZero() {
+		to = time.Now()
+	}
+	if from.IsZero() {
+		from = to.AddDate(0, 0, -30)
+	}
+	if !from.Before(to) {
+		return apiserver.ImplResponse{Code: http.StatusBadRequest}, nil
+	}
+	availabilities, err := conf.GetSystemAvailability(ctx, *config, from, to)
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	return apiserver.Response(http.StatusOK, append([]apiserver.SystemAvailability{}, availabilities...)), nil
+}
+
+func (s *ConfigurationApiService) GetSuppressedAssetsByConfigId(ctx context.Context, configId int64) (apiserver.ImplResponse, error) {
+	config, err := conf.GetConfig(ctx, configId)
+	if errors.Is(err, conf.ErrBadRequest) {
+		return apiserver.ImplResponse{Code: http.StatusBadRequest}, nil
+	}
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	suppressed, err := eliona.GetSuppressedAssets(*config)
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	return apiserver.Response(http.StatusOK, suppressed), nil
+}
+
+func (s *ConfigurationApiService) PostRecreateSuppressedAssetsByConfigId(ctx context.Context, configId int64, selection apiserver.SuppressedAssetSelection) (apiserver.ImplResponse, error) {
+	config, err := conf.GetConfig(ctx, configId)
+	if errors.Is(err, conf.ErrBadRequest) {
+		return apiserver.ImplResponse{Code: http.StatusBadRequest}, nil
+	}
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	recreated, err := eliona.RecreateSuppressedAssets(*config, selection.AssetIds)
+	if errors.Is(err, conf.ErrBadRequest) {
+		return apiserver.ImplResponse{Code: http.StatusBadRequest}, nil
+	}
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	// The discovery completes the re-created assets, the value refresh fills them.
+	schedule.Trigger(configId, schedule.Discovery)
+	schedule.Trigger(configId, schedule.ValueRefresh)
+	return apiserver.Response(http.StatusOK, recreated), nil
+}
+
+func (s *ConfigurationApiService) PostForgetSuppressedAssetsByConfigId(ctx context.Context, configId int64, selection apiserver.SuppressedAssetSelection) (apiserver.ImplResponse, error) {
+	config, err := conf.GetConfig(ctx, configId)
+	if errors.Is(err, conf.ErrBadRequest) {
+		return apiserver.ImplResponse{Code: http.StatusBadRequest}, nil
+	}
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	forgotten, err := eliona.ForgetSuppressedAssets(*config, selection.AssetIds)
+	if errors.Is(err, conf.ErrBadRequest) {
+		return apiserver.ImplResponse{Code: http.StatusBadRequest}, nil
+	}
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	return apiserver.Response(http.StatusOK, forgotten), nil
+}
+
+func (s *ConfigurationApiService) GetAttributeTransformationsByConfigId(ctx context.Context, configId int64) (apiserver.ImplResponse, error) {
+	config, err := conf.GetConfig(ctx, configId)
+	if errors.Is(err, conf.ErrBadRequest) {
+		return apiserver.ImplResponse{Code: http.StatusBadRequest}, nil
+	}
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	transformations, err := conf.GetAttributeTransformationsByConfig(ctx, *config)
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	return apiserver.Response(http.StatusOK, transformations), nil
+}
+
+func (s *ConfigurationApiService) PutAttributeTransformationByConfigId(ctx context.Context, configId int64, transformation apiserver.AttributeTransformation) (apiserver.ImplResponse, error) {
+	config, err := conf.GetConfig(ctx, configId)
+	if errors.Is(err, conf.ErrBadRequest) {
+		return apiserver.ImplResponse{Code: http.StatusBadRequest}, nil
+	}
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	if err := model.ValidateTransformation(transformation.Rules); err != nil {
+		return apiserver.ImplResponse{Code: http.StatusBadRequest}, nil
+	}
+	transformation, err = conf.SetAttributeTransformation(ctx, *config, transformation)
+	if errors.Is(err, conf.ErrBadRequest) {
+		return apiserver.ImplResponse{Code: http.StatusBadRequest}, nil
+	}
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	// The value refresh pushes the values transformed with the new rules.
+	schedule.Trigger(configId, schedule.ValueRefresh)
+	return apiserver.Response(http.StatusOK, transformation), nil
+}
+
+func (s *ConfigurationApiService) PostSyncJobByConfigId(ctx context.Context, configId int64, request apiserver.SyncRequest) (apiserver.ImplResponse, error) {
+	config, err := conf.GetConfig(ctx, configId)
+	if errors.Is(err, conf.ErrBadRequest) {
+		return apiserver.ImplResponse{Code: http.StatusBadRequest}, nil
+	} else if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	// Disabled configurations run no tasks that would pick up the job.
+	if !conf.IsConfigEnabled(*config) {
+		return apiserver.ImplResponse{Code: http.StatusConflict}, nil
+	}
+	job, err := conf.CreateSyncJob(ctx, configId, request.Mode)
+	if errors.Is(err, conf.ErrBadRequest) {
+		return apiserver.ImplResponse{Code: http.StatusBadRequest}, nil
+	}
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	// The job is picked up by the triggered task.
+	if request.Mode == conf.SYNC_MODE_VALUES {
+		schedule.Trigger(configId, schedule.ValueRefresh)
+	} else {
+		schedule.Trigger(configId, schedule.Discovery)
+	}
+	return apiserver.Response(http.StatusAccepted, job), nil
+}
+
+func (s *ConfigurationApiService) GetSyncJobById(ctx context.Context, configId int64, jobId int64) (apiserver.ImplResponse, error) {
+	job, err := conf.GetSyncJob(ctx, configId, jobId)
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	if job == nil {
+		return apiserver.ImplResponse{Code: http.StatusNotFound}, nil
+	}
+	return apiserver.Response(http.StatusOK, job), nil
+}
+
+func (s *ConfigurationApiService) PostDiscoveryByConfigId(ctx context.Context, configId int64) (apiserver.ImplResponse, error) {
+	if _, err := conf.GetConfig(ctx, configId); errors.Is(err, conf.ErrBadRequest) {
+		return apiserver.ImplResponse{Code: http.StatusBadRequest}, nil
+	} else if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	schedule.Trigger(configId, schedule.Discovery)
+	return apiserver.ImplResponse{Code: http.StatusAccepted}, nil
+}
+
+func (s *ConfigurationApiService) PostValueRefreshByConfigId(ctx context.Context, configId int64) (apiserver.ImplResponse, error) {
+	if _, err := conf.GetConfig(ctx, configId); errors.Is(err, conf.ErrBadRequest) {
+		return apiserver.ImplResponse{Code: http.StatusBadRequest}, nil
+	} else if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	schedule.Trigger(configId, schedule.ValueRefresh)
+	return apiserver.ImplResponse{Code: http.StatusAccepted}, nil
+}
+
+func (s *ConfigurationApiService) PostMappingReconciliationByConfigId(ctx context.Context, configId int64) (apiserver.ImplResponse, error) {
+	config, err := conf.GetConfig(ctx, configId)
+	if errors.Is(err, conf.ErrBadRequest) {
+		return apiserver.ImplResponse{Code: http.StatusBadRequest}, nil
+	}
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	locations, err := broker.GetLocations(config)
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, fmt.Errorf("getting abb locations: %v", err)
+	}
+	systems, err := broker.GetSystems(config, locations)
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, fmt.Errorf("getting abb configuration: %v", err)
+	}
+	changes, err := eliona.ReconcileMappings(*config, systems)
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	return apiserver.Response(http.StatusOK, apiserver.MappingReconciliation{
+		ConfigId: configId,
+		Changes:  append([]string{}, changes...),
+	}), nil
+}
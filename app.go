@@ -19,12 +19,19 @@ import (
 	"abb-free-at-home/abbgraphql"
 	"abb-free-at-home/apiserver"
 	"abb-free-at-home/apiservices"
+	"abb-free-at-home/appdb"
 	"abb-free-at-home/broker"
 	"abb-free-at-home/conf"
 	"abb-free-at-home/eliona"
+	"abb-free-at-home/model"
+	"abb-free-at-home/schedule"
 	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"slices"
 	"strconv"
 	"sync"
 	"time"
@@ -37,10 +44,33 @@ import (
 	"github.com/eliona-smart-building-assistant/go-utils/db"
 	utilshttp "github.com/eliona-smart-building-assistant/go-utils/http"
 	"github.com/eliona-smart-building-assistant/go-utils/log"
+	"github.com/volatiletech/null/v8"
 )
 
 var once sync.Once
-var resynchronizeTrigger = make(chan struct{}, 1)
+
+// discovered holds the configurations whose assets were discovered since the app started.
+var discovered = struct {
+	mu      sync.Mutex
+	configs map[int64]bool
+}{configs: make(map[int64]bool)}
+
+const bufferReplayInterval = 30 * time.Second
+const outageCheckInterval = time.Minute
+
+const (
+	// Consecutive failed datapoint subscriptions after which the values are polled instead.
+	subscriptionFailureLimit = 3
+	dataPollingInterval      = 30 * time.Second
+	// How long the values are polled before the subscription is tried again.
+	subscriptionRetryInterval = 10 * time.Minute
+)
+
+// subscriptionFailures counts the consecutive failed datapoint subscriptions per configuration.
+var subscriptionFailures = struct {
+	mu     sync.Mutex
+	counts map[int64]int
+}{counts: make(map[int64]int)}
 
 func collectData() {
 	configs, err := conf.GetConfigs(context.Background())
@@ -60,6 +90,9 @@ func collectData() {
 			if conf.IsConfigActive(config) {
 				conf.SetConfigActiveState(context.Background(), config, false)
 			}
+			if err := conf.FailPendingSyncJobs(context.Background(), *config.Id); err != nil {
+				log.Error("conf", "failing pending sync jobs of config %d: %v", *config.Id, err)
+			}
 			continue
 		}
 
@@ -68,13 +101,17 @@ func collectData() {
 			log.Info("conf", "Collecting initialized with Configuration %d:\n"+
 				"Enable: %t\n"+
 				"Refresh Interval: %d\n"+
+				"Discovery Interval: %d\n"+
 				"Request Timeout: %d\n"+
-				"Project IDs: %v\n",
+				"Project IDs: %v\n"+
+				"System Project IDs: %v\n",
 				*config.Id,
 				*config.Enable,
 				config.RefreshInterval,
+				common.Val(config.DiscoveryInterval),
 				*config.RequestTimeout,
-				*config.ProjectIDs)
+				*config.ProjectIDs,
+				common.Val(config.SystemProjectIDs))
 		}
 		collectAndStartSubscription(config)
 	}
@@ -82,106 +119,437 @@ func collectData() {
 
 func collectAndStartSubscription(config apiserver.Configuration) {
 	common.RunOnceWithParam(func(config apiserver.Configuration) {
-		log.Info("main", "Collecting %d started", *config.Id)
+		log.Info("main", "Discovery %d started", *config.Id)
 
-		if err := collectResources(&config); err != nil {
+		if err := discover(&config); err != nil {
 			// Delay before retry. This makes sure that a bug won't put too much
 			// strain on ABB servers.
 			time.Sleep(5 * time.Minute)
 			return // Error is handled in the method itself.
 		}
+		setDiscovered(*config.Id)
+
+		log.Info("main", "Discovery %d finished", *config.Id)
+
+		next := conf.DiscoverySchedule(config).Next(time.Now().UTC())
+		if schedule.Wait(*config.Id, schedule.Discovery, next) {
+			log.Info("main", "Discovery %d triggered.", *config.Id)
+		}
+	}, config, *config.Id)
+
+	// Values can only be written to assets that were discovered before.
+	if !isDiscovered(*config.Id) {
+		return
+	}
+	common.RunOnceWithParam(func(config apiserver.Configuration) {
+		log.Info("main", "Value refresh %d started", *config.Id)
+
+		if err := refreshValues(&config); err != nil {
+			time.Sleep(5 * time.Minute)
+			return // Error is handled in the method itself.
+		}
 
-		log.Info("main", "Collecting %d finished", *config.Id)
+		log.Info("main", "Value refresh %d finished", *config.Id)
 
-		common.RunOnceWithParam(func(config apiserver.Configuration) {
+		next := conf.ValueRefreshSchedule(config).Next(time.Now().UTC())
+		if schedule.Wait(*config.Id, schedule.ValueRefresh, next) {
+			log.Info("main", "Value refresh %d triggered.", *config.Id)
+		}
+	}, config, fmt.Sprintf("refresh_%v", *config.Id))
+	common.RunOnceWithParam(func(config apiserver.Configuration) {
+		if failures := countSubscriptionFailure(*config.Id, false); failures >= subscriptionFailureLimit {
+			log.Warn("main", "Subscription %d failed %d times in a row, polling datapoints instead.", *config.Id, failures)
+			pollDataChanges(&config, subscriptionRetryInterval)
+			log.Info("main", "Polling %d stopped, retrying subscription ...", *config.Id)
+		} else {
 			log.Info("main", "Subscription %d started.", *config.Id)
-			subscribeToDataChanges(&config)
+			healthy := subscribeToDataChanges(&config)
+			countSubscriptionFailure(*config.Id, !healthy)
 			log.Info("main", "Subscription %d exited. Restarting ...", *config.Id)
-			triggerResynchronize()
-		}, config, fmt.Sprintf("subscription_%v", *config.Id))
-		common.RunOnceWithParam(func(config apiserver.Configuration) {
-			log.Info("main", "Status subscription %d started.", *config.Id)
-			subscribeToSystemStatus(&config)
-			log.Info("main", "Status subscription %d exited. Restarting ...", *config.Id)
-			triggerResynchronize()
-		}, config, fmt.Sprintf("status_subscription_%v", *config.Id))
-		for {
-			// Wait for the time duration or a trigger
-			select {
-			case <-time.After(time.Second * time.Duration(config.RefreshInterval)):
-				return
-			case <-resynchronizeTrigger:
-				log.Info("main", "Resynchronization trigerred.")
-				return
-			}
 		}
-	}, config, *config.Id)
+		// Catch up on the values changed while the subscription was down.
+		schedule.Trigger(*config.Id, schedule.ValueRefresh)
+	}, config, fmt.Sprintf("subscription_%v", *config.Id))
+	common.RunOnceWithParam(func(config apiserver.Configuration) {
+		log.Info("main", "Status subscription %d started.", *config.Id)
+		subscribeToSystemStatus(&config)
+		log.Info("main", "Status subscription %d exited. Restarting ...", *config.Id)
+		schedule.Trigger(*config.Id, schedule.ValueRefresh)
+	}, config, fmt.Sprintf("status_subscription_%v", *config.Id))
+	common.RunOnceWithParam(func(config apiserver.Configuration) {
+		replayBufferedData(&config)
+	}, config, fmt.Sprintf("buffer_replay_%v", *config.Id))
+	common.RunOnceWithParam(func(config apiserver.Configuration) {
+		watchSystemOutages(&config)
+	}, config, fmt.Sprintf("outage_watch_%v", *config.Id))
+}
+
+func setDiscovered(configID int64) {
+	discovered.mu.Lock()
+	defer discovered.mu.Unlock()
+	discovered.configs[configID] = true
+}
+
+func isDiscovered(configID int64) bool {
+	discovered.mu.Lock()
+	defer discovered.mu.Unlock()
+	return discovered.configs[configID]
+}
+
+// syncJobs are the synchronization jobs requested through the API and run by a task.
+type syncJobs []*appdb.SyncJob
+
+func startSyncJobs(configID int64, modes ...string) syncJobs {
+	jobs, err := conf.StartSyncJobs(context.Background(), configID, modes...)
+	if err != nil {
+		log.Error("conf", "starting sync jobs of config %d: %v", configID, err)
+	}
+	return jobs
+}
+
+func (jobs syncJobs) withMode(mode string) syncJobs {
+	var filtered syncJobs
+	for _, job := range jobs {
+		if job.Mode == mode {
+			filtered = append(filtered, job)
+		}
+	}
+	return filtered
+}
+
+func (jobs syncJobs) step(progress int32, step string) {
+	for _, job := range jobs {
+		job.Progress = progress
+		job.Step = step
+		jobs.update(job)
+	}
 }
 
-func triggerResynchronize() {
-	// Non-blocking Send: This ensures that sending to the channel doesn't block if the channel buffer is full.
-	select {
-	case resynchronizeTrigger <- struct{}{}:
-	default:
+// addError records an error that did not stop the synchronization.
+func (jobs syncJobs) addError(err error) {
+	for _, job := range jobs {
+		var errs []string
+		_ = json.Unmarshal(job.Errors, &errs)
+		job.Errors, _ = json.Marshal(append(errs, err.Error()))
+		jobs.update(job)
 	}
 }
 
-func collectResources(config *apiserver.Configuration) error {
+func (jobs syncJobs) finish(stats *eliona.SyncStats, changes []string, err error) {
+	if err != nil {
+		jobs.addError(err)
+	}
+	for _, job := range jobs {
+		job.Status = conf.SYNC_STATUS_FINISHED
+		if err != nil {
+			job.Status = conf.SYNC_STATUS_FAILED
+		} else {
+			job.Progress = 100
+			job.Step = ""
+		}
+		if stats != nil {
+			job.AssetsCreated = int32(stats.Created)
+			job.AssetsUpdated = int32(stats.Updated)
+			job.AssetsOrphaned = int32(stats.Orphaned)
+			job.AssetsRetired = int32(stats.Retired)
+		}
+		if changes != nil {
+			job.Changes, _ = json.Marshal(changes)
+		}
+		job.FinishedAt = null.TimeFrom(time.Now())
+		jobs.update(job)
+	}
+}
+
+func (jobs syncJobs) update(job *appdb.SyncJob) {
+	if err := conf.UpdateSyncJob(context.Background(), job); err != nil {
+		log.Error("conf", "updating sync job %d: %v", job.ID, err)
+	}
+}
+
+// discover creates the assets for the locations and devices reported by ABB and retires the
+// assets of the ones no longer reported. It runs the pending discovery and reconcile jobs.
+func discover(config *apiserver.Configuration) (err error) {
+	jobs := startSyncJobs(*config.Id, conf.SYNC_MODE_DISCOVERY, conf.SYNC_MODE_RECONCILE)
+	stats := &eliona.SyncStats{}
+	var changes []string
+	defer func() {
+		jobs.finish(stats, changes, err)
+	}()
+
+	jobs.step(10, "getting locations")
 	locations, err := broker.GetLocations(config)
 	if err != nil {
 		log.Error("abb", "getting abb locations: %v", err)
 		return err
 	}
-	if err := eliona.CreateLocationAssetsIfNecessary(*config, locations); err != nil {
+	jobs.step(20, "creating location assets")
+	if err := eliona.CreateLocationAssetsIfNecessary(*config, locations, stats); err != nil {
 		log.Error("eliona", "creating location assets: %v", err)
 		return err
 	}
 
-	systems, err := broker.GetSystems(config)
+	jobs.step(40, "getting devices")
+	systems, err := broker.GetSystems(config, locations)
 	if err != nil {
 		log.Error("abb", "getting abb configuration: %v", err)
 		return err
 	}
-	if err := eliona.CreateAssetsIfNecessary(*config, systems); err != nil {
+	jobs.step(50, "creating assets")
+	if err := eliona.CreateAssetsIfNecessary(*config, locations, systems, stats); err != nil {
 		log.Error("eliona", "creating assets: %v", err)
 		return err
 	}
+	jobs.step(80, "retiring orphaned assets")
+	if err := eliona.RetireOrphanedAssets(*config, locations, systems, stats); err != nil {
+		log.Error("eliona", "retiring orphaned assets: %v", err)
+		return err
+	}
 
+	if reconcileJobs := jobs.withMode(conf.SYNC_MODE_RECONCILE); len(reconcileJobs) > 0 {
+		reconcileJobs.step(90, "reconciling mappings")
+		changes, err = eliona.ReconcileMappings(*config, systems)
+		if err != nil {
+			log.Error("eliona", "reconciling mappings: %v", err)
+			return err
+		}
+	}
+	return nil
+}
+
+// refreshValues writes the current values of all devices reported by ABB to Eliona. It runs
+// the pending value jobs.
+func refreshValues(config *apiserver.Configuration) (err error) {
+	jobs := startSyncJobs(*config.Id, conf.SYNC_MODE_VALUES)
+	defer func() {
+		jobs.finish(nil, nil, err)
+	}()
+
+	// The locations are needed to evaluate the asset filter.
+	jobs.step(10, "getting locations")
+	locations, err := broker.GetLocations(config)
+	if err != nil {
+		log.Error("abb", "getting abb locations: %v", err)
+		return err
+	}
+	jobs.step(30, "getting devices")
+	systems, err := broker.GetSystems(config, locations)
+	if err != nil {
+		log.Error("abb", "getting abb configuration: %v", err)
+		return err
+	}
+	for _, system := range systems {
+		if !system.ConnectionKnown {
+			continue
+		}
+		if err := conf.RecordSystemConnection(context.Background(), *config, system.ID, system.ConnectionStatus == 1, time.Now()); err != nil {
+			log.Error("conf", "recording connection state of system %s: %v", system.ID, err)
+		}
+	}
+	// Sets the alert states of the devices, must run before the data upsert.
+	jobs.step(50, "evaluating device alerts")
+	if err := eliona.EvaluateDeviceAlerts(*config, systems); err != nil {
+		// Alerts are evaluated again with the next refresh, keep the data flowing.
+		log.Error("eliona", "evaluating device alerts: %v", err)
+		jobs.addError(fmt.Errorf("evaluating device alerts: %v", err))
+	}
+
+	// Buffered updates must be delivered first to keep the order of values.
+	jobs.step(60, "replaying buffered data")
+	pending, err := eliona.ReplayBufferedData(*config)
+	if err != nil {
+		log.Error("eliona", "replaying buffered data: %v", err)
+		return err
+	}
+	if pending {
+		log.Warn("eliona", "buffered data of config %d not yet delivered, skipping data upsert", *config.Id)
+		jobs.addError(errors.New("buffered data not yet delivered, values skipped"))
+		return nil
+	}
+
+	// Must run before the regular upsert, otherwise the older values would
+	// overwrite the current ones.
+	jobs.step(70, "backfilling values")
+	backfillIfNecessary(config, systems)
+
+	jobs.step(80, "writing values")
+	broker.ClearHeldRings(systems)
 	if err := eliona.UpsertSystemsData(*config, systems); err != nil {
 		log.Error("eliona", "inserting data into Eliona: %v", err)
 		return err
 	}
+	if err := conf.SetSyncWatermark(context.Background(), *config, time.Now()); err != nil {
+		log.Error("conf", "setting sync watermark for config %d: %v", *config.Id, err)
+	}
 	return nil
 }
 
+// backfillIfNecessary recovers the values changed at ABB while the data was not
+// synchronized for longer than the configured threshold after the refresh was due.
+func backfillIfNecessary(config *apiserver.Configuration, systems []model.System) {
+	if config.BackfillThreshold == nil || *config.BackfillThreshold <= 0 {
+		return
+	}
+	syncedAt, err := conf.GetSyncWatermark(context.Background(), *config)
+	if err != nil {
+		log.Error("conf", "getting sync watermark for config %d: %v", *config.Id, err)
+		return
+	}
+	if syncedAt == nil {
+		// First synchronization, there is no gap to fill.
+		return
+	}
+	gap := conf.ValueRefreshSchedule(*config).Overdue(*syncedAt, time.Now())
+	if gap <= time.Duration(*config.BackfillThreshold)*time.Second {
+		return
+	}
+	log.Info("eliona", "config %d was not synchronized since %v, backfilling changed values", *config.Id, *syncedAt)
+	written, err := eliona.BackfillSystemsData(*config, systems, *syncedAt)
+	if err != nil {
+		log.Error("eliona", "backfilling data for config %d: %v", *config.Id, err)
+	}
+	log.Info("eliona", "backfilled %d values for config %d", written, *config.Id)
+}
+
+// countSubscriptionFailure records the outcome of a datapoint subscription and returns the
+// number of consecutive failures. Called with failed false before the subscription, it only
+// reads the count.
+func countSubscriptionFailure(configID int64, failed bool) int {
+	subscriptionFailures.mu.Lock()
+	defer subscriptionFailures.mu.Unlock()
+	if failed {
+		subscriptionFailures.counts[configID]++
+	}
+	return subscriptionFailures.counts[configID]
+}
+
+func resetSubscriptionFailures(configID int64) {
+	subscriptionFailures.mu.Lock()
+	defer subscriptionFailures.mu.Unlock()
+	delete(subscriptionFailures.counts, configID)
+}
+
 // ABB -> Eliona
-func subscribeToDataChanges(config *apiserver.Configuration) {
-	datapoints, err := conf.FetchAllDatapoints()
+// subscribeToDataChanges returns false if the subscription failed without delivering any value.
+func subscribeToDataChanges(config *apiserver.Configuration) bool {
+	datapoints, err := conf.FetchAllDatapoints(*config)
 	if err != nil {
 		log.Error("conf", "fetching all datapoints: %v", err)
-		return
+		return true // Not a subscription failure.
 	}
 
 	dataPointChan := make(chan abbgraphql.DataPoint)
+	var listenErr error
 	go func() {
 		defer close(dataPointChan)
 
-		if err := broker.ListenForDataChanges(config, datapoints, dataPointChan); err != nil {
-			log.Error("broker", "listen for data changes: %v", err)
+		if listenErr = broker.ListenForDataChanges(config, datapoints, dataPointChan); listenErr != nil {
+			log.Error("broker", "listen for data changes: %v", listenErr)
 			return
 		}
 		log.Info("broker", "ABB subscription exited")
 	}()
+	received := false
 	for dp := range dataPointChan {
+		if !received {
+			// The subscription works, forget previous failures.
+			received = true
+			resetSubscriptionFailures(*config.Id)
+		}
 		datapoint, err := conf.FindOutputDatapoint(dp.SerialNumber, dp.ChannelNumber, dp.DatapointId)
 		if err != nil {
 			log.Error("conf", "finding output datapoint %+v: %v", dp, err)
 			continue
 		}
-		if err := eliona.UpsertDatapointData(*config, datapoint, dp.Value); err != nil {
+		if err := eliona.UpsertDatapointData(*config, datapoint, dp.Value, nil); err != nil {
 			log.Error("eliona", "upserting datapoint data %+v: %v", dp, err)
 			continue
 		}
+		handleReportedValue(*config, datapoint, dp.Value)
+	}
+	return received || listenErr == nil
+}
+
+// handleReportedValue reacts to a value change reported by ABB: triggers return to their reset
+// value and rings notify the user.
+func handleReportedValue(config apiserver.Configuration, datapoint appdb.Datapoint, value string) {
+	val, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return
+	}
+	resetMomentary(config, datapoint.AssetID, datapoint.Function, val, false)
+	if datapoint.Function == broker.NOTIFY_ON_RING && val != 0 {
+		if err := eliona.NotifyRing(config, datapoint); err != nil {
+			log.Error("eliona", "notifying about ring of asset %v: %v", datapoint.AssetID, err)
+		}
+	}
+}
+
+// pollDataChanges periodically reads the datapoint values for the given duration. It replaces
+// the subscription while that keeps failing. Only changed values are written to Eliona.
+func pollDataChanges(config *apiserver.Configuration, duration time.Duration) {
+	datapoints, err := conf.FetchAllDatapoints(*config)
+	if err != nil {
+		log.Error("conf", "fetching all datapoints: %v", err)
+		return
+	}
+	byKey := make(map[abbgraphql.DataPoint]appdb.Datapoint)
+	for _, dp := range datapoints {
+		byKey[abbgraphql.DataPoint{SerialNumber: dp.DeviceID, ChannelNumber: dp.ChannelID, DatapointId: dp.Datapoint}] = dp
+	}
+
+	lastValues := make(map[abbgraphql.DataPoint]string)
+	until := time.Now().Add(duration)
+	for time.Now().Before(until) {
+		values, err := broker.ReadDataPoints(config, datapoints)
+		if err != nil {
+			log.Error("broker", "polling datapoints: %v", err)
+		}
+		for _, dp := range values {
+			key := dp
+			key.Value = ""
+			if last, ok := lastValues[key]; ok && last == dp.Value {
+				continue
+			}
+			datapoint, ok := byKey[key]
+			if !ok {
+				log.Debug("broker", "polled unknown datapoint %+v", dp)
+				continue
+			}
+			if err := eliona.UpsertDatapointData(*config, datapoint, dp.Value, nil); err != nil {
+				log.Error("eliona", "upserting polled datapoint data %+v: %v", dp, err)
+				continue
+			}
+			handleReportedValue(*config, datapoint, dp.Value)
+			lastValues[key] = dp.Value
+		}
+		time.Sleep(dataPollingInterval)
+	}
+}
+
+// replayBufferedData keeps delivering the updates buffered while Eliona was unavailable.
+func replayBufferedData(config *apiserver.Configuration) {
+	for {
+		if _, err := eliona.ReplayBufferedData(*config); err != nil {
+			log.Error("eliona", "replaying buffered data: %v", err)
+		}
+		time.Sleep(bufferReplayInterval)
+	}
+}
+
+// watchSystemOutages keeps notifying the user about SysAPs offline for longer than the threshold.
+func watchSystemOutages(config *apiserver.Configuration) {
+	for {
+		// Reload the configuration to pick up a changed threshold.
+		current, err := conf.GetConfig(context.Background(), *config.Id)
+		if err != nil {
+			log.Error("conf", "fetching config %d: %v", *config.Id, err)
+			current = config
+		}
+		if err := eliona.NotifyLongOutages(*current); err != nil {
+			log.Error("eliona", "notifying about SysAP outages: %v", err)
+		}
+		time.Sleep(outageCheckInterval)
 	}
 }
 
@@ -192,9 +560,12 @@ func subscribeToSystemStatus(config *apiserver.Configuration) {
 		return
 	}
 
+	// A system has one asset in each project it is mapped to.
 	var dtIDs []string
 	for _, s := range systems {
-		dtIDs = append(dtIDs, s.ProviderID)
+		if !slices.Contains(dtIDs, s.ProviderID) {
+			dtIDs = append(dtIDs, s.ProviderID)
+		}
 	}
 
 	connectionStatusChan := make(chan abbgraphql.ConnectionStatus)
@@ -214,6 +585,9 @@ func subscribeToSystemStatus(config *apiserver.Configuration) {
 			log.Error("conf", "finding system %+v: %v", status.DtId, err)
 			return
 		}
+		if err := conf.RecordSystemConnection(context.Background(), *config, status.DtId, status.Connected, time.Now()); err != nil {
+			log.Error("conf", "recording connection state of system %s: %v", status.DtId, err)
+		}
 		connected := int8(0)
 		if status.Connected {
 			connected = 1
@@ -257,18 +631,9 @@ func listenForOutputChanges() {
 				if !ok {
 					continue
 				}
-				var value float64
-
-				switch v := val.(type) {
-				case float64:
-					value = v
-				case string:
-					if value, err = strconv.ParseFloat(v, 64); err != nil {
-						log.Error("app", "output: parsing %v: %v", v, err)
-						continue
-					}
-				default:
-					log.Error("app", "output: got value of unknown type: %v", val)
+				value, err := eliona.InputValue(output.AssetId, function, val)
+				if err != nil {
+					log.Error("app", "output: converting %v for asset %v function %v: %v", val, output.AssetId, function, err)
 					continue
 				}
 				setAsset(output.AssetId, function, value)
@@ -316,20 +681,33 @@ func setAsset(assetID int32, function string, val float64) {
 		}
 	}
 
-	// This hack is to enable "triggger" functionality in Eliona. The user
-	// triggers the attribute by setting it to "1", then the app immediately
-	// sets it back to "0".
-	if function == broker.SET_SCENE_RETURN_TO_ZERO {
-		output, err := conf.FindOutputDatapoint(input.DeviceID, input.ChannelID, input.Datapoint)
-		if err != nil {
-			log.Error("conf", "finding datapoint corresponding to %v: %v", input, err)
-			return
+	resetMomentary(config, assetID, function, val, true)
+}
+
+// resetMomentary returns a momentary function of the asset to its reset value once the declared
+// delay passed, so that its attribute acts as a trigger. Values written from Eliona are reset at
+// ABB as well if the function requires it.
+func resetMomentary(config apiserver.Configuration, assetID int32, function string, val float64, fromEliona bool) {
+	momentary, ok := broker.MomentaryFunction(function)
+	if !ok || val == momentary.ResetValue {
+		return
+	}
+	time.AfterFunc(momentary.Delay, func() {
+		if fromEliona && momentary.ResetABB {
+			setAsset(assetID, function, momentary.ResetValue)
 		}
-		if err := eliona.UpsertDatapointData(config, output, "0"); err != nil {
-			log.Error("eliona", "returning scene trigger back to zero: %v", err)
+		output, err := conf.FetchOutput(assetID, function)
+		if errors.Is(err, sql.ErrNoRows) {
+			return // Nothing to show in Eliona.
+		} else if err != nil {
+			log.Error("conf", "fetching output for asset %v function %v: %v", assetID, function, err)
 			return
 		}
-	}
+		value := strconv.FormatFloat(momentary.ResetValue, 'f', -1, 64)
+		if err := eliona.UpsertDatapointData(config, output, value, nil); err != nil {
+			log.Error("eliona", "resetting %v of asset %v: %v", function, assetID, err)
+		}
+	})
 }
 
 func initialize() {
@@ -346,7 +724,15 @@ func initialize() {
 		dashboard.InitWidgetTypeFiles("resources/widget-types/*.json"),
 	)
 
-	// Patch the app to v1.1.3. Note that database migration must be done manually.
+	// Schema changes are versioned migrations, applied before the patches that may rely on them.
+	if err := conf.Migrate(ctx, conn); err != nil {
+		log.Fatal("conf", "migrating database: %v", err)
+	}
+	if err := conf.FailInterruptedSyncJobs(ctx); err != nil {
+		log.Error("conf", "failing interrupted sync jobs: %v", err)
+	}
+
+	// Patch the app to v1.1.3.
 	app.Patch(conn, app.AppName(), "010103",
 		asset.InitAssetTypeFiles("resources/asset-types/*.json"),
 	)
@@ -361,4 +747,42 @@ func initialize() {
 	app.Patch(conn, app.AppName(), "010112",
 		asset.InitAssetTypeFiles("resources/asset-types/*.json"),
 	)
+	// Add datapoints mapped by this app version to existing assets
+	app.Patch(conn, app.AppName(), "010205",
+		queueMappingReconciliation,
+	)
+	// Update asset types definition - device and system metadata
+	app.Patch(conn, app.AppName(), "010206",
+		asset.InitAssetTypeFiles("resources/asset-types/*.json"),
+	)
+	// Update asset types definition - signal quality and device alerts
+	app.Patch(conn, app.AppName(), "010207",
+		asset.InitAssetTypeFiles("resources/asset-types/*.json"),
+	)
+	// Update asset types definition - door entry system
+	app.Patch(conn, app.AppName(), "010208",
+		asset.InitAssetTypeFiles("resources/asset-types/*.json"),
+	)
+}
+
+// queueMappingReconciliation requests a reconciliation of the datapoint mappings of the existing
+// assets of all enabled configurations. The jobs are run by the next discovery, so that the app
+// starts without waiting for ABB and a failed reconciliation is reported in its job.
+func queueMappingReconciliation(db.Connection) error {
+	ctx := context.Background()
+	configs, err := conf.GetConfigs(ctx)
+	if err != nil {
+		return fmt.Errorf("reading configs: %v", err)
+	}
+	for _, config := range configs {
+		if !conf.IsConfigEnabled(config) {
+			continue
+		}
+		job, err := conf.CreateSyncJob(ctx, *config.Id, conf.SYNC_MODE_RECONCILE)
+		if err != nil {
+			return fmt.Errorf("creating reconciliation job for config %d: %v", *config.Id, err)
+		}
+		log.Info("conf", "queued mapping reconciliation job %d of config %d", job.Id, *config.Id)
+	}
+	return nil
 }
@@ -24,52 +24,77 @@ import (
 
 // Asset is an object representing the database table.
 type Asset struct {
-	ID              int64      `boil:"id" json:"id" toml:"id" yaml:"id"`
-	ConfigurationID int64      `boil:"configuration_id" json:"configuration_id" toml:"configuration_id" yaml:"configuration_id"`
-	ProjectID       string     `boil:"project_id" json:"project_id" toml:"project_id" yaml:"project_id"`
-	GlobalAssetID   string     `boil:"global_asset_id" json:"global_asset_id" toml:"global_asset_id" yaml:"global_asset_id"`
-	AssetTypeName   string     `boil:"asset_type_name" json:"asset_type_name" toml:"asset_type_name" yaml:"asset_type_name"`
-	ProviderID      string     `boil:"provider_id" json:"provider_id" toml:"provider_id" yaml:"provider_id"`
-	AssetID         null.Int32 `boil:"asset_id" json:"asset_id,omitempty" toml:"asset_id" yaml:"asset_id,omitempty"`
+	ID                 int64       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ConfigurationID    int64       `boil:"configuration_id" json:"configuration_id" toml:"configuration_id" yaml:"configuration_id"`
+	ProjectID          string      `boil:"project_id" json:"project_id" toml:"project_id" yaml:"project_id"`
+	GlobalAssetID      string      `boil:"global_asset_id" json:"global_asset_id" toml:"global_asset_id" yaml:"global_asset_id"`
+	AssetTypeName      string      `boil:"asset_type_name" json:"asset_type_name" toml:"asset_type_name" yaml:"asset_type_name"`
+	ProviderID         string      `boil:"provider_id" json:"provider_id" toml:"provider_id" yaml:"provider_id"`
+	AssetID            null.Int32  `boil:"asset_id" json:"asset_id,omitempty" toml:"asset_id" yaml:"asset_id,omitempty"`
+	OrphanedAt         null.Time   `boil:"orphaned_at" json:"orphaned_at,omitempty" toml:"orphaned_at" yaml:"orphaned_at,omitempty"`
+	RetiredAt          null.Time   `boil:"retired_at" json:"retired_at,omitempty" toml:"retired_at" yaml:"retired_at,omitempty"`
+	Name               null.String `boil:"name" json:"name,omitempty" toml:"name" yaml:"name,omitempty"`
+	LocationalParentID null.Int32  `boil:"locational_parent_id" json:"locational_parent_id,omitempty" toml:"locational_parent_id" yaml:"locational_parent_id,omitempty"`
+	ForgottenAt        null.Time   `boil:"forgotten_at" json:"forgotten_at,omitempty" toml:"forgotten_at" yaml:"forgotten_at,omitempty"`
 
 	R *assetR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L assetL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var AssetColumns = struct {
-	ID              string
-	ConfigurationID string
-	ProjectID       string
-	GlobalAssetID   string
-	AssetTypeName   string
-	ProviderID      string
-	AssetID         string
+	ID                 string
+	ConfigurationID    string
+	ProjectID          string
+	GlobalAssetID      string
+	AssetTypeName      string
+	ProviderID         string
+	AssetID            string
+	OrphanedAt         string
+	RetiredAt          string
+	Name               string
+	LocationalParentID string
+	ForgottenAt        string
 }{
-	ID:              "id",
-	ConfigurationID: "configuration_id",
-	ProjectID:       "project_id",
-	GlobalAssetID:   "global_asset_id",
-	AssetTypeName:   "asset_type_name",
-	ProviderID:      "provider_id",
-	AssetID:         "asset_id",
+	ID:                 "id",
+	ConfigurationID:    "configuration_id",
+	ProjectID:          "project_id",
+	GlobalAssetID:      "global_asset_id",
+	AssetTypeName:      "asset_type_name",
+	ProviderID:         "provider_id",
+	AssetID:            "asset_id",
+	OrphanedAt:         "orphaned_at",
+	RetiredAt:          "retired_at",
+	Name:               "name",
+	LocationalParentID: "locational_parent_id",
+	ForgottenAt:        "forgotten_at",
 }
 
 var AssetTableColumns = struct {
-	ID              string
-	ConfigurationID string
-	ProjectID       string
-	GlobalAssetID   string
-	AssetTypeName   string
-	ProviderID      string
-	AssetID         string
+	ID                 string
+	ConfigurationID    string
+	ProjectID          string
+	GlobalAssetID      string
+	AssetTypeName      string
+	ProviderID         string
+	AssetID            string
+	OrphanedAt         string
+	RetiredAt          string
+	Name               string
+	LocationalParentID string
+	ForgottenAt        string
 }{
-	ID:              "asset.id",
-	ConfigurationID: "asset.configuration_id",
-	ProjectID:       "asset.project_id",
-	GlobalAssetID:   "asset.global_asset_id",
-	AssetTypeName:   "asset.asset_type_name",
-	ProviderID:      "asset.provider_id",
-	AssetID:         "asset.asset_id",
+	ID:                 "asset.id",
+	ConfigurationID:    "asset.configuration_id",
+	ProjectID:          "asset.project_id",
+	GlobalAssetID:      "asset.global_asset_id",
+	AssetTypeName:      "asset.asset_type_name",
+	ProviderID:         "asset.provider_id",
+	AssetID:            "asset.asset_id",
+	OrphanedAt:         "asset.orphaned_at",
+	RetiredAt:          "asset.retired_at",
+	Name:               "asset.name",
+	LocationalParentID: "asset.locational_parent_id",
+	ForgottenAt:        "asset.forgotten_at",
 }
 
 // Generated where
@@ -97,26 +122,34 @@ func (w whereHelperint64) NIN(slice []int64) qm.QueryMod {
 	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
 }
 
-type whereHelperstring struct{ field string }
+type whereHelpernull_Int32 struct{ field string }
 
-func (w whereHelperstring) EQ(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperstring) NEQ(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperstring) LT(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperstring) LTE(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperstring) GT(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperstring) GTE(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperstring) LIKE(x string) qm.QueryMod   { return qm.Where(w.field+" LIKE ?", x) }
-func (w whereHelperstring) NLIKE(x string) qm.QueryMod  { return qm.Where(w.field+" NOT LIKE ?", x) }
-func (w whereHelperstring) ILIKE(x string) qm.QueryMod  { return qm.Where(w.field+" ILIKE ?", x) }
-func (w whereHelperstring) NILIKE(x string) qm.QueryMod { return qm.Where(w.field+" NOT ILIKE ?", x) }
-func (w whereHelperstring) IN(slice []string) qm.QueryMod {
+func (w whereHelpernull_Int32) EQ(x null.Int32) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Int32) NEQ(x null.Int32) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Int32) LT(x null.Int32) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Int32) LTE(x null.Int32) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Int32) GT(x null.Int32) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Int32) GTE(x null.Int32) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelpernull_Int32) IN(slice []int32) qm.QueryMod {
 	values := make([]interface{}, 0, len(slice))
 	for _, value := range slice {
 		values = append(values, value)
 	}
 	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
 }
-func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
+func (w whereHelpernull_Int32) NIN(slice []int32) qm.QueryMod {
 	values := make([]interface{}, 0, len(slice))
 	for _, value := range slice {
 		values = append(values, value)
@@ -124,34 +157,73 @@ func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
 	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
 }
 
-type whereHelpernull_Int32 struct{ field string }
+func (w whereHelpernull_Int32) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Int32) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
 
-func (w whereHelpernull_Int32) EQ(x null.Int32) qm.QueryMod {
+type whereHelpernull_Time struct{ field string }
+
+func (w whereHelpernull_Time) EQ(x null.Time) qm.QueryMod {
 	return qmhelper.WhereNullEQ(w.field, false, x)
 }
-func (w whereHelpernull_Int32) NEQ(x null.Int32) qm.QueryMod {
+func (w whereHelpernull_Time) NEQ(x null.Time) qm.QueryMod {
 	return qmhelper.WhereNullEQ(w.field, true, x)
 }
-func (w whereHelpernull_Int32) LT(x null.Int32) qm.QueryMod {
+func (w whereHelpernull_Time) LT(x null.Time) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.LT, x)
 }
-func (w whereHelpernull_Int32) LTE(x null.Int32) qm.QueryMod {
+func (w whereHelpernull_Time) LTE(x null.Time) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.LTE, x)
 }
-func (w whereHelpernull_Int32) GT(x null.Int32) qm.QueryMod {
+func (w whereHelpernull_Time) GT(x null.Time) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.GT, x)
 }
-func (w whereHelpernull_Int32) GTE(x null.Int32) qm.QueryMod {
+func (w whereHelpernull_Time) GTE(x null.Time) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.GTE, x)
 }
-func (w whereHelpernull_Int32) IN(slice []int32) qm.QueryMod {
+
+func (w whereHelpernull_Time) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Time) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+type whereHelpernull_String struct{ field string }
+
+func (w whereHelpernull_String) EQ(x null.String) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_String) NEQ(x null.String) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_String) LT(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_String) LTE(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_String) GT(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_String) GTE(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelpernull_String) LIKE(x null.String) qm.QueryMod {
+	return qm.Where(w.field+" LIKE ?", x)
+}
+func (w whereHelpernull_String) NLIKE(x null.String) qm.QueryMod {
+	return qm.Where(w.field+" NOT LIKE ?", x)
+}
+func (w whereHelpernull_String) ILIKE(x null.String) qm.QueryMod {
+	return qm.Where(w.field+" ILIKE ?", x)
+}
+func (w whereHelpernull_String) NILIKE(x null.String) qm.QueryMod {
+	return qm.Where(w.field+" NOT ILIKE ?", x)
+}
+func (w whereHelpernull_String) IN(slice []string) qm.QueryMod {
 	values := make([]interface{}, 0, len(slice))
 	for _, value := range slice {
 		values = append(values, value)
 	}
 	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
 }
-func (w whereHelpernull_Int32) NIN(slice []int32) qm.QueryMod {
+func (w whereHelpernull_String) NIN(slice []string) qm.QueryMod {
 	values := make([]interface{}, 0, len(slice))
 	for _, value := range slice {
 		values = append(values, value)
@@ -159,25 +231,35 @@ func (w whereHelpernull_Int32) NIN(slice []int32) qm.QueryMod {
 	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
 }
 
-func (w whereHelpernull_Int32) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelpernull_Int32) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+func (w whereHelpernull_String) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_String) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
 
 var AssetWhere = struct {
-	ID              whereHelperint64
-	ConfigurationID whereHelperint64
-	ProjectID       whereHelperstring
-	GlobalAssetID   whereHelperstring
-	AssetTypeName   whereHelperstring
-	ProviderID      whereHelperstring
-	AssetID         whereHelpernull_Int32
+	ID                 whereHelperint64
+	ConfigurationID    whereHelperint64
+	ProjectID          whereHelperstring
+	GlobalAssetID      whereHelperstring
+	AssetTypeName      whereHelperstring
+	ProviderID         whereHelperstring
+	AssetID            whereHelpernull_Int32
+	OrphanedAt         whereHelpernull_Time
+	RetiredAt          whereHelpernull_Time
+	Name               whereHelpernull_String
+	LocationalParentID whereHelpernull_Int32
+	ForgottenAt        whereHelpernull_Time
 }{
-	ID:              whereHelperint64{field: "\"abb_free_at_home\".\"asset\".\"id\""},
-	ConfigurationID: whereHelperint64{field: "\"abb_free_at_home\".\"asset\".\"configuration_id\""},
-	ProjectID:       whereHelperstring{field: "\"abb_free_at_home\".\"asset\".\"project_id\""},
-	GlobalAssetID:   whereHelperstring{field: "\"abb_free_at_home\".\"asset\".\"global_asset_id\""},
-	AssetTypeName:   whereHelperstring{field: "\"abb_free_at_home\".\"asset\".\"asset_type_name\""},
-	ProviderID:      whereHelperstring{field: "\"abb_free_at_home\".\"asset\".\"provider_id\""},
-	AssetID:         whereHelpernull_Int32{field: "\"abb_free_at_home\".\"asset\".\"asset_id\""},
+	ID:                 whereHelperint64{field: "\"abb_free_at_home\".\"asset\".\"id\""},
+	ConfigurationID:    whereHelperint64{field: "\"abb_free_at_home\".\"asset\".\"configuration_id\""},
+	ProjectID:          whereHelperstring{field: "\"abb_free_at_home\".\"asset\".\"project_id\""},
+	GlobalAssetID:      whereHelperstring{field: "\"abb_free_at_home\".\"asset\".\"global_asset_id\""},
+	AssetTypeName:      whereHelperstring{field: "\"abb_free_at_home\".\"asset\".\"asset_type_name\""},
+	ProviderID:         whereHelperstring{field: "\"abb_free_at_home\".\"asset\".\"provider_id\""},
+	AssetID:            whereHelpernull_Int32{field: "\"abb_free_at_home\".\"asset\".\"asset_id\""},
+	OrphanedAt:         whereHelpernull_Time{field: "\"abb_free_at_home\".\"asset\".\"orphaned_at\""},
+	RetiredAt:          whereHelpernull_Time{field: "\"abb_free_at_home\".\"asset\".\"retired_at\""},
+	Name:               whereHelpernull_String{field: "\"abb_free_at_home\".\"asset\".\"name\""},
+	LocationalParentID: whereHelpernull_Int32{field: "\"abb_free_at_home\".\"asset\".\"locational_parent_id\""},
+	ForgottenAt:        whereHelpernull_Time{field: "\"abb_free_at_home\".\"asset\".\"forgotten_at\""},
 }
 
 // AssetRels is where relationship names are stored.
@@ -218,9 +300,9 @@ func (r *assetR) GetDatapoints() DatapointSlice {
 type assetL struct{}
 
 var (
-	assetAllColumns            = []string{"id", "configuration_id", "project_id", "global_asset_id", "asset_type_name", "provider_id", "asset_id"}
+	assetAllColumns            = []string{"id", "configuration_id", "project_id", "global_asset_id", "asset_type_name", "provider_id", "asset_id", "orphaned_at", "retired_at", "name", "locational_parent_id", "forgotten_at"}
 	assetColumnsWithoutDefault = []string{"project_id", "global_asset_id", "asset_type_name", "provider_id"}
-	assetColumnsWithDefault    = []string{"id", "configuration_id", "asset_id"}
+	assetColumnsWithDefault    = []string{"id", "configuration_id", "asset_id", "orphaned_at", "retired_at", "name", "locational_parent_id", "forgotten_at"}
 	assetPrimaryKeyColumns     = []string{"id"}
 	assetGeneratedColumns      = []string{}
 )
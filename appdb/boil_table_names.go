@@ -4,13 +4,27 @@
 package appdb
 
 var TableNames = struct {
-	Asset              string
-	Configuration      string
-	Datapoint          string
-	DatapointAttribute string
+	AlarmRule             string
+	Asset                 string
+	BufferedData          string
+	Configuration         string
+	Datapoint             string
+	DatapointAttribute    string
+	DatapointWatermark    string
+	DeviceAlert           string
+	SyncJob               string
+	SyncWatermark         string
+	SystemConnectionEvent string
 }{
-	Asset:              "asset",
-	Configuration:      "configuration",
-	Datapoint:          "datapoint",
-	DatapointAttribute: "datapoint_attribute",
+	AlarmRule:             "alarm_rule",
+	Asset:                 "asset",
+	BufferedData:          "buffered_data",
+	Configuration:         "configuration",
+	Datapoint:             "datapoint",
+	DatapointAttribute:    "datapoint_attribute",
+	DatapointWatermark:    "datapoint_watermark",
+	DeviceAlert:           "device_alert",
+	SyncJob:               "sync_job",
+	SyncWatermark:         "sync_watermark",
+	SystemConnectionEvent: "system_connection_event",
 }
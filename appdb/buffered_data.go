@@ -0,0 +1,1300 @@
+// Code generated by SQLBoiler 4.16.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package appdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"github.com/volatiletech/strmangle"
+)
+
+// BufferedDatum is an object representing the database table.
+type BufferedDatum struct {
+	ID              int64      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ConfigurationID int64      `boil:"configuration_id" json:"configuration_id" toml:"configuration_id" yaml:"configuration_id"`
+	AssetID         int32      `boil:"asset_id" json:"asset_id" toml:"asset_id" yaml:"asset_id"`
+	AssetTypeName   string     `boil:"asset_type_name" json:"asset_type_name" toml:"asset_type_name" yaml:"asset_type_name"`
+	Subtype         string     `boil:"subtype" json:"subtype" toml:"subtype" yaml:"subtype"`
+	AttributeName   string     `boil:"attribute_name" json:"attribute_name" toml:"attribute_name" yaml:"attribute_name"`
+	Value           types.JSON `boil:"value" json:"value" toml:"value" yaml:"value"`
+	ValueTime       time.Time  `boil:"value_time" json:"value_time" toml:"value_time" yaml:"value_time"`
+
+	R *bufferedDatumR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L bufferedDatumL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var BufferedDatumColumns = struct {
+	ID              string
+	ConfigurationID string
+	AssetID         string
+	AssetTypeName   string
+	Subtype         string
+	AttributeName   string
+	Value           string
+	ValueTime       string
+}{
+	ID:              "id",
+	ConfigurationID: "configuration_id",
+	AssetID:         "asset_id",
+	AssetTypeName:   "asset_type_name",
+	Subtype:         "subtype",
+	AttributeName:   "attribute_name",
+	Value:           "value",
+	ValueTime:       "value_time",
+}
+
+var BufferedDatumTableColumns = struct {
+	ID              string
+	ConfigurationID string
+	AssetID         string
+	AssetTypeName   string
+	Subtype         string
+	AttributeName   string
+	Value           string
+	ValueTime       string
+}{
+	ID:              "buffered_data.id",
+	ConfigurationID: "buffered_data.configuration_id",
+	AssetID:         "buffered_data.asset_id",
+	AssetTypeName:   "buffered_data.asset_type_name",
+	Subtype:         "buffered_data.subtype",
+	AttributeName:   "buffered_data.attribute_name",
+	Value:           "buffered_data.value",
+	ValueTime:       "buffered_data.value_time",
+}
+
+// Generated where
+
+type whereHelpertypes_JSON struct{ field string }
+
+func (w whereHelpertypes_JSON) EQ(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelpertypes_JSON) NEQ(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelpertypes_JSON) LT(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertypes_JSON) LTE(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertypes_JSON) GT(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertypes_JSON) GTE(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+type whereHelpertime_Time struct{ field string }
+
+func (w whereHelpertime_Time) EQ(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelpertime_Time) NEQ(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelpertime_Time) LT(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertime_Time) LTE(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertime_Time) GT(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertime_Time) GTE(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+var BufferedDatumWhere = struct {
+	ID              whereHelperint64
+	ConfigurationID whereHelperint64
+	AssetID         whereHelperint32
+	AssetTypeName   whereHelperstring
+	Subtype         whereHelperstring
+	AttributeName   whereHelperstring
+	Value           whereHelpertypes_JSON
+	ValueTime       whereHelpertime_Time
+}{
+	ID:              whereHelperint64{field: "\"abb_free_at_home\".\"buffered_data\".\"id\""},
+	ConfigurationID: whereHelperint64{field: "\"abb_free_at_home\".\"buffered_data\".\"configuration_id\""},
+	AssetID:         whereHelperint32{field: "\"abb_free_at_home\".\"buffered_data\".\"asset_id\""},
+	AssetTypeName:   whereHelperstring{field: "\"abb_free_at_home\".\"buffered_data\".\"asset_type_name\""},
+	Subtype:         whereHelperstring{field: "\"abb_free_at_home\".\"buffered_data\".\"subtype\""},
+	AttributeName:   whereHelperstring{field: "\"abb_free_at_home\".\"buffered_data\".\"attribute_name\""},
+	Value:           whereHelpertypes_JSON{field: "\"abb_free_at_home\".\"buffered_data\".\"value\""},
+	ValueTime:       whereHelpertime_Time{field: "\"abb_free_at_home\".\"buffered_data\".\"value_time\""},
+}
+
+// BufferedDatumRels is where relationship names are stored.
+var BufferedDatumRels = struct {
+	Configuration string
+}{
+	Configuration: "Configuration",
+}
+
+// bufferedDatumR is where relationships are stored.
+type bufferedDatumR struct {
+	Configuration *Configuration `boil:"Configuration" json:"Configuration" toml:"Configuration" yaml:"Configuration"`
+}
+
+// NewStruct creates a new relationship struct
+func (*bufferedDatumR) NewStruct() *bufferedDatumR {
+	return &bufferedDatumR{}
+}
+
+func (r *bufferedDatumR) GetConfiguration() *Configuration {
+	if r == nil {
+		return nil
+	}
+	return r.Configuration
+}
+
+// bufferedDatumL is where Load methods for each relationship are stored.
+type bufferedDatumL struct{}
+
+var (
+	bufferedDatumAllColumns            = []string{"id", "configuration_id", "asset_id", "asset_type_name", "subtype", "attribute_name", "value", "value_time"}
+	bufferedDatumColumnsWithoutDefault = []string{"configuration_id", "asset_id", "asset_type_name", "subtype", "attribute_name", "value", "value_time"}
+	bufferedDatumColumnsWithDefault    = []string{"id"}
+	bufferedDatumPrimaryKeyColumns     = []string{"id"}
+	bufferedDatumGeneratedColumns      = []string{}
+)
+
+type (
+	// BufferedDatumSlice is an alias for a slice of pointers to BufferedDatum.
+	// This should almost always be used instead of []BufferedDatum.
+	BufferedDatumSlice []*BufferedDatum
+	// BufferedDatumHook is the signature for custom BufferedDatum hook methods
+	BufferedDatumHook func(context.Context, boil.ContextExecutor, *BufferedDatum) error
+
+	bufferedDatumQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	bufferedDatumType                 = reflect.TypeOf(&BufferedDatum{})
+	bufferedDatumMapping              = queries.MakeStructMapping(bufferedDatumType)
+	bufferedDatumPrimaryKeyMapping, _ = queries.BindMapping(bufferedDatumType, bufferedDatumMapping, bufferedDatumPrimaryKeyColumns)
+	bufferedDatumInsertCacheMut       sync.RWMutex
+	bufferedDatumInsertCache          = make(map[string]insertCache)
+	bufferedDatumUpdateCacheMut       sync.RWMutex
+	bufferedDatumUpdateCache          = make(map[string]updateCache)
+	bufferedDatumUpsertCacheMut       sync.RWMutex
+	bufferedDatumUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var bufferedDatumAfterSelectMu sync.Mutex
+var bufferedDatumAfterSelectHooks []BufferedDatumHook
+
+var bufferedDatumBeforeInsertMu sync.Mutex
+var bufferedDatumBeforeInsertHooks []BufferedDatumHook
+var bufferedDatumAfterInsertMu sync.Mutex
+var bufferedDatumAfterInsertHooks []BufferedDatumHook
+
+var bufferedDatumBeforeUpdateMu sync.Mutex
+var bufferedDatumBeforeUpdateHooks []BufferedDatumHook
+var bufferedDatumAfterUpdateMu sync.Mutex
+var bufferedDatumAfterUpdateHooks []BufferedDatumHook
+
+var bufferedDatumBeforeDeleteMu sync.Mutex
+var bufferedDatumBeforeDeleteHooks []BufferedDatumHook
+var bufferedDatumAfterDeleteMu sync.Mutex
+var bufferedDatumAfterDeleteHooks []BufferedDatumHook
+
+var bufferedDatumBeforeUpsertMu sync.Mutex
+var bufferedDatumBeforeUpsertHooks []BufferedDatumHook
+var bufferedDatumAfterUpsertMu sync.Mutex
+var bufferedDatumAfterUpsertHooks []BufferedDatumHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *BufferedDatum) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range bufferedDatumAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *BufferedDatum) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range bufferedDatumBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *BufferedDatum) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range bufferedDatumAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *BufferedDatum) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range bufferedDatumBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *BufferedDatum) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range bufferedDatumAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *BufferedDatum) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range bufferedDatumBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *BufferedDatum) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range bufferedDatumAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *BufferedDatum) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range bufferedDatumBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *BufferedDatum) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range bufferedDatumAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddBufferedDatumHook registers your hook function for all future operations.
+func AddBufferedDatumHook(hookPoint boil.HookPoint, bufferedDatumHook BufferedDatumHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		bufferedDatumAfterSelectMu.Lock()
+		bufferedDatumAfterSelectHooks = append(bufferedDatumAfterSelectHooks, bufferedDatumHook)
+		bufferedDatumAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		bufferedDatumBeforeInsertMu.Lock()
+		bufferedDatumBeforeInsertHooks = append(bufferedDatumBeforeInsertHooks, bufferedDatumHook)
+		bufferedDatumBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		bufferedDatumAfterInsertMu.Lock()
+		bufferedDatumAfterInsertHooks = append(bufferedDatumAfterInsertHooks, bufferedDatumHook)
+		bufferedDatumAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		bufferedDatumBeforeUpdateMu.Lock()
+		bufferedDatumBeforeUpdateHooks = append(bufferedDatumBeforeUpdateHooks, bufferedDatumHook)
+		bufferedDatumBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		bufferedDatumAfterUpdateMu.Lock()
+		bufferedDatumAfterUpdateHooks = append(bufferedDatumAfterUpdateHooks, bufferedDatumHook)
+		bufferedDatumAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		bufferedDatumBeforeDeleteMu.Lock()
+		bufferedDatumBeforeDeleteHooks = append(bufferedDatumBeforeDeleteHooks, bufferedDatumHook)
+		bufferedDatumBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		bufferedDatumAfterDeleteMu.Lock()
+		bufferedDatumAfterDeleteHooks = append(bufferedDatumAfterDeleteHooks, bufferedDatumHook)
+		bufferedDatumAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		bufferedDatumBeforeUpsertMu.Lock()
+		bufferedDatumBeforeUpsertHooks = append(bufferedDatumBeforeUpsertHooks, bufferedDatumHook)
+		bufferedDatumBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		bufferedDatumAfterUpsertMu.Lock()
+		bufferedDatumAfterUpsertHooks = append(bufferedDatumAfterUpsertHooks, bufferedDatumHook)
+		bufferedDatumAfterUpsertMu.Unlock()
+	}
+}
+
+// OneG returns a single bufferedDatum record from the query using the global executor.
+func (q bufferedDatumQuery) OneG(ctx context.Context) (*BufferedDatum, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single bufferedDatum record from the query.
+func (q bufferedDatumQuery) One(ctx context.Context, exec boil.ContextExecutor) (*BufferedDatum, error) {
+	o := &BufferedDatum{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "appdb: failed to execute a one query for buffered_data")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all BufferedDatum records from the query using the global executor.
+func (q bufferedDatumQuery) AllG(ctx context.Context) (BufferedDatumSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all BufferedDatum records from the query.
+func (q bufferedDatumQuery) All(ctx context.Context, exec boil.ContextExecutor) (BufferedDatumSlice, error) {
+	var o []*BufferedDatum
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "appdb: failed to assign all query results to BufferedDatum slice")
+	}
+
+	if len(bufferedDatumAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all BufferedDatum records in the query using the global executor
+func (q bufferedDatumQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all BufferedDatum records in the query.
+func (q bufferedDatumQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to count buffered_data rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q bufferedDatumQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q bufferedDatumQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "appdb: failed to check if buffered_data exists")
+	}
+
+	return count > 0, nil
+}
+
+// Configuration pointed to by the foreign key.
+func (o *BufferedDatum) Configuration(mods ...qm.QueryMod) configurationQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ConfigurationID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Configurations(queryMods...)
+}
+
+// LoadConfiguration allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (bufferedDatumL) LoadConfiguration(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBufferedDatum interface{}, mods queries.Applicator) error {
+	var slice []*BufferedDatum
+	var object *BufferedDatum
+
+	if singular {
+		var ok bool
+		object, ok = maybeBufferedDatum.(*BufferedDatum)
+		if !ok {
+			object = new(BufferedDatum)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeBufferedDatum)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeBufferedDatum))
+			}
+		}
+	} else {
+		s, ok := maybeBufferedDatum.(*[]*BufferedDatum)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeBufferedDatum)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeBufferedDatum))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &bufferedDatumR{}
+		}
+		args[object.ConfigurationID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &bufferedDatumR{}
+			}
+
+			args[obj.ConfigurationID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`abb_free_at_home.configuration`),
+		qm.WhereIn(`abb_free_at_home.configuration.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Configuration")
+	}
+
+	var resultSlice []*Configuration
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Configuration")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for configuration")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for configuration")
+	}
+
+	if len(configurationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Configuration = foreign
+		if foreign.R == nil {
+			foreign.R = &configurationR{}
+		}
+		foreign.R.BufferedData = append(foreign.R.BufferedData, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ConfigurationID == foreign.ID {
+				local.R.Configuration = foreign
+				if foreign.R == nil {
+					foreign.R = &configurationR{}
+				}
+				foreign.R.BufferedData = append(foreign.R.BufferedData, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetConfigurationG of the bufferedDatum to the related item.
+// Sets o.R.Configuration to related.
+// Adds o to related.R.BufferedData.
+// Uses the global database handle.
+func (o *BufferedDatum) SetConfigurationG(ctx context.Context, insert bool, related *Configuration) error {
+	return o.SetConfiguration(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetConfiguration of the bufferedDatum to the related item.
+// Sets o.R.Configuration to related.
+// Adds o to related.R.BufferedData.
+func (o *BufferedDatum) SetConfiguration(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Configuration) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"abb_free_at_home\".\"buffered_data\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"configuration_id"}),
+		strmangle.WhereClause("\"", "\"", 2, bufferedDatumPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ConfigurationID = related.ID
+	if o.R == nil {
+		o.R = &bufferedDatumR{
+			Configuration: related,
+		}
+	} else {
+		o.R.Configuration = related
+	}
+
+	if related.R == nil {
+		related.R = &configurationR{
+			BufferedData: BufferedDatumSlice{o},
+		}
+	} else {
+		related.R.BufferedData = append(related.R.BufferedData, o)
+	}
+
+	return nil
+}
+
+// BufferedData retrieves all the records using an executor.
+func BufferedData(mods ...qm.QueryMod) bufferedDatumQuery {
+	mods = append(mods, qm.From("\"abb_free_at_home\".\"buffered_data\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"abb_free_at_home\".\"buffered_data\".*"})
+	}
+
+	return bufferedDatumQuery{q}
+}
+
+// FindBufferedDatumG retrieves a single record by ID.
+func FindBufferedDatumG(ctx context.Context, iD int64, selectCols ...string) (*BufferedDatum, error) {
+	return FindBufferedDatum(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindBufferedDatum retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindBufferedDatum(ctx context.Context, exec boil.ContextExecutor, iD int64, selectCols ...string) (*BufferedDatum, error) {
+	bufferedDatumObj := &BufferedDatum{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"abb_free_at_home\".\"buffered_data\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, bufferedDatumObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "appdb: unable to select from buffered_data")
+	}
+
+	if err = bufferedDatumObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return bufferedDatumObj, err
+	}
+
+	return bufferedDatumObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *BufferedDatum) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *BufferedDatum) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("appdb: no buffered_data provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(bufferedDatumColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	bufferedDatumInsertCacheMut.RLock()
+	cache, cached := bufferedDatumInsertCache[key]
+	bufferedDatumInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			bufferedDatumAllColumns,
+			bufferedDatumColumnsWithDefault,
+			bufferedDatumColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(bufferedDatumType, bufferedDatumMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(bufferedDatumType, bufferedDatumMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"abb_free_at_home\".\"buffered_data\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"abb_free_at_home\".\"buffered_data\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to insert into buffered_data")
+	}
+
+	if !cached {
+		bufferedDatumInsertCacheMut.Lock()
+		bufferedDatumInsertCache[key] = cache
+		bufferedDatumInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single BufferedDatum record using the global executor.
+// See Update for more documentation.
+func (o *BufferedDatum) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the BufferedDatum.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *BufferedDatum) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	bufferedDatumUpdateCacheMut.RLock()
+	cache, cached := bufferedDatumUpdateCache[key]
+	bufferedDatumUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			bufferedDatumAllColumns,
+			bufferedDatumPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("appdb: unable to update buffered_data, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"abb_free_at_home\".\"buffered_data\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, bufferedDatumPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(bufferedDatumType, bufferedDatumMapping, append(wl, bufferedDatumPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update buffered_data row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by update for buffered_data")
+	}
+
+	if !cached {
+		bufferedDatumUpdateCacheMut.Lock()
+		bufferedDatumUpdateCache[key] = cache
+		bufferedDatumUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q bufferedDatumQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q bufferedDatumQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update all for buffered_data")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to retrieve rows affected for buffered_data")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o BufferedDatumSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o BufferedDatumSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("appdb: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), bufferedDatumPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"abb_free_at_home\".\"buffered_data\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, bufferedDatumPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update all in bufferedDatum slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to retrieve rows affected all in update all bufferedDatum")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *BufferedDatum) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *BufferedDatum) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("appdb: no buffered_data provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(bufferedDatumColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	bufferedDatumUpsertCacheMut.RLock()
+	cache, cached := bufferedDatumUpsertCache[key]
+	bufferedDatumUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			bufferedDatumAllColumns,
+			bufferedDatumColumnsWithDefault,
+			bufferedDatumColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			bufferedDatumAllColumns,
+			bufferedDatumPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("appdb: unable to upsert buffered_data, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(bufferedDatumAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(bufferedDatumPrimaryKeyColumns) == 0 {
+				return errors.New("appdb: unable to upsert buffered_data, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(bufferedDatumPrimaryKeyColumns))
+			copy(conflict, bufferedDatumPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"abb_free_at_home\".\"buffered_data\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(bufferedDatumType, bufferedDatumMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(bufferedDatumType, bufferedDatumMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to upsert buffered_data")
+	}
+
+	if !cached {
+		bufferedDatumUpsertCacheMut.Lock()
+		bufferedDatumUpsertCache[key] = cache
+		bufferedDatumUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single BufferedDatum record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *BufferedDatum) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single BufferedDatum record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *BufferedDatum) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("appdb: no BufferedDatum provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), bufferedDatumPrimaryKeyMapping)
+	sql := "DELETE FROM \"abb_free_at_home\".\"buffered_data\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete from buffered_data")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by delete for buffered_data")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q bufferedDatumQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q bufferedDatumQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("appdb: no bufferedDatumQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete all from buffered_data")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by deleteall for buffered_data")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o BufferedDatumSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o BufferedDatumSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(bufferedDatumBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), bufferedDatumPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"abb_free_at_home\".\"buffered_data\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, bufferedDatumPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete all from bufferedDatum slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by deleteall for buffered_data")
+	}
+
+	if len(bufferedDatumAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *BufferedDatum) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("appdb: no BufferedDatum provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *BufferedDatum) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindBufferedDatum(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *BufferedDatumSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("appdb: empty BufferedDatumSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *BufferedDatumSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := BufferedDatumSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), bufferedDatumPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"abb_free_at_home\".\"buffered_data\".* FROM \"abb_free_at_home\".\"buffered_data\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, bufferedDatumPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to reload all in BufferedDatumSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// BufferedDatumExistsG checks if the BufferedDatum row exists.
+func BufferedDatumExistsG(ctx context.Context, iD int64) (bool, error) {
+	return BufferedDatumExists(ctx, boil.GetContextDB(), iD)
+}
+
+// BufferedDatumExists checks if the BufferedDatum row exists.
+func BufferedDatumExists(ctx context.Context, exec boil.ContextExecutor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"abb_free_at_home\".\"buffered_data\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "appdb: unable to check if buffered_data exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the BufferedDatum row exists.
+func (o *BufferedDatum) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return BufferedDatumExists(ctx, exec, o.ID)
+}
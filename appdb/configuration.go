@@ -25,122 +25,237 @@ import (
 
 // Configuration is an object representing the database table.
 type Configuration struct {
-	ID              int64             `boil:"id" json:"id" toml:"id" yaml:"id"`
-	IsLocal         bool              `boil:"is_local" json:"is_local" toml:"is_local" yaml:"is_local"`
-	IsMybuildings   bool              `boil:"is_mybuildings" json:"is_mybuildings" toml:"is_mybuildings" yaml:"is_mybuildings"`
-	IsProservice    bool              `boil:"is_proservice" json:"is_proservice" toml:"is_proservice" yaml:"is_proservice"`
-	ClientID        null.String       `boil:"client_id" json:"client_id,omitempty" toml:"client_id" yaml:"client_id,omitempty"`
-	ClientSecret    null.String       `boil:"client_secret" json:"client_secret,omitempty" toml:"client_secret" yaml:"client_secret,omitempty"`
-	AccessToken     null.String       `boil:"access_token" json:"access_token,omitempty" toml:"access_token" yaml:"access_token,omitempty"`
-	RefreshToken    null.String       `boil:"refresh_token" json:"refresh_token,omitempty" toml:"refresh_token" yaml:"refresh_token,omitempty"`
-	Expiry          null.Time         `boil:"expiry" json:"expiry,omitempty" toml:"expiry" yaml:"expiry,omitempty"`
-	APIKey          null.String       `boil:"api_key" json:"api_key,omitempty" toml:"api_key" yaml:"api_key,omitempty"`
-	OrgUUID         null.String       `boil:"org_uuid" json:"org_uuid,omitempty" toml:"org_uuid" yaml:"org_uuid,omitempty"`
-	APIURL          null.String       `boil:"api_url" json:"api_url,omitempty" toml:"api_url" yaml:"api_url,omitempty"`
-	APIUsername     null.String       `boil:"api_username" json:"api_username,omitempty" toml:"api_username" yaml:"api_username,omitempty"`
-	APIPassword     null.String       `boil:"api_password" json:"api_password,omitempty" toml:"api_password" yaml:"api_password,omitempty"`
-	RefreshInterval int32             `boil:"refresh_interval" json:"refresh_interval" toml:"refresh_interval" yaml:"refresh_interval"`
-	RequestTimeout  int32             `boil:"request_timeout" json:"request_timeout" toml:"request_timeout" yaml:"request_timeout"`
-	AssetFilter     null.JSON         `boil:"asset_filter" json:"asset_filter,omitempty" toml:"asset_filter" yaml:"asset_filter,omitempty"`
-	Active          null.Bool         `boil:"active" json:"active,omitempty" toml:"active" yaml:"active,omitempty"`
-	Enable          null.Bool         `boil:"enable" json:"enable,omitempty" toml:"enable" yaml:"enable,omitempty"`
-	ProjectIds      types.StringArray `boil:"project_ids" json:"project_ids,omitempty" toml:"project_ids" yaml:"project_ids,omitempty"`
-	UserID          null.String       `boil:"user_id" json:"user_id,omitempty" toml:"user_id" yaml:"user_id,omitempty"`
+	ID                           int64             `boil:"id" json:"id" toml:"id" yaml:"id"`
+	IsLocal                      bool              `boil:"is_local" json:"is_local" toml:"is_local" yaml:"is_local"`
+	IsMybuildings                bool              `boil:"is_mybuildings" json:"is_mybuildings" toml:"is_mybuildings" yaml:"is_mybuildings"`
+	IsProservice                 bool              `boil:"is_proservice" json:"is_proservice" toml:"is_proservice" yaml:"is_proservice"`
+	ClientID                     null.String       `boil:"client_id" json:"client_id,omitempty" toml:"client_id" yaml:"client_id,omitempty"`
+	ClientSecret                 null.String       `boil:"client_secret" json:"client_secret,omitempty" toml:"client_secret" yaml:"client_secret,omitempty"`
+	AccessToken                  null.String       `boil:"access_token" json:"access_token,omitempty" toml:"access_token" yaml:"access_token,omitempty"`
+	RefreshToken                 null.String       `boil:"refresh_token" json:"refresh_token,omitempty" toml:"refresh_token" yaml:"refresh_token,omitempty"`
+	Expiry                       null.Time         `boil:"expiry" json:"expiry,omitempty" toml:"expiry" yaml:"expiry,omitempty"`
+	APIKey                       null.String       `boil:"api_key" json:"api_key,omitempty" toml:"api_key" yaml:"api_key,omitempty"`
+	OrgUUID                      null.String       `boil:"org_uuid" json:"org_uuid,omitempty" toml:"org_uuid" yaml:"org_uuid,omitempty"`
+	APIURL                       null.String       `boil:"api_url" json:"api_url,omitempty" toml:"api_url" yaml:"api_url,omitempty"`
+	APIUsername                  null.String       `boil:"api_username" json:"api_username,omitempty" toml:"api_username" yaml:"api_username,omitempty"`
+	APIPassword                  null.String       `boil:"api_password" json:"api_password,omitempty" toml:"api_password" yaml:"api_password,omitempty"`
+	RefreshInterval              int32             `boil:"refresh_interval" json:"refresh_interval" toml:"refresh_interval" yaml:"refresh_interval"`
+	RequestTimeout               int32             `boil:"request_timeout" json:"request_timeout" toml:"request_timeout" yaml:"request_timeout"`
+	AssetFilter                  null.JSON         `boil:"asset_filter" json:"asset_filter,omitempty" toml:"asset_filter" yaml:"asset_filter,omitempty"`
+	Active                       null.Bool         `boil:"active" json:"active,omitempty" toml:"active" yaml:"active,omitempty"`
+	Enable                       null.Bool         `boil:"enable" json:"enable,omitempty" toml:"enable" yaml:"enable,omitempty"`
+	ProjectIds                   types.StringArray `boil:"project_ids" json:"project_ids,omitempty" toml:"project_ids" yaml:"project_ids,omitempty"`
+	UserID                       null.String       `boil:"user_id" json:"user_id,omitempty" toml:"user_id" yaml:"user_id,omitempty"`
+	BackfillThreshold            int32             `boil:"backfill_threshold" json:"backfill_threshold" toml:"backfill_threshold" yaml:"backfill_threshold"`
+	BufferSize                   int32             `boil:"buffer_size" json:"buffer_size" toml:"buffer_size" yaml:"buffer_size"`
+	BufferCompaction             bool              `boil:"buffer_compaction" json:"buffer_compaction" toml:"buffer_compaction" yaml:"buffer_compaction"`
+	OrphanGracePeriod            int32             `boil:"orphan_grace_period" json:"orphan_grace_period" toml:"orphan_grace_period" yaml:"orphan_grace_period"`
+	OrphanPolicy                 string            `boil:"orphan_policy" json:"orphan_policy" toml:"orphan_policy" yaml:"orphan_policy"`
+	GaiScope                     null.String       `boil:"gai_scope" json:"gai_scope,omitempty" toml:"gai_scope" yaml:"gai_scope,omitempty"`
+	RootAssetName                string            `boil:"root_asset_name" json:"root_asset_name" toml:"root_asset_name" yaml:"root_asset_name"`
+	SystemProjectIds             null.JSON         `boil:"system_project_ids" json:"system_project_ids,omitempty" toml:"system_project_ids" yaml:"system_project_ids,omitempty"`
+	DeviceNameTemplate           string            `boil:"device_name_template" json:"device_name_template" toml:"device_name_template" yaml:"device_name_template"`
+	ChannelNameTemplate          string            `boil:"channel_name_template" json:"channel_name_template" toml:"channel_name_template" yaml:"channel_name_template"`
+	DeviceDescriptionTemplate    string            `boil:"device_description_template" json:"device_description_template" toml:"device_description_template" yaml:"device_description_template"`
+	ChannelDescriptionTemplate   string            `boil:"channel_description_template" json:"channel_description_template" toml:"channel_description_template" yaml:"channel_description_template"`
+	Language                     string            `boil:"language" json:"language" toml:"language" yaml:"language"`
+	LowBatteryThreshold          int32             `boil:"low_battery_threshold" json:"low_battery_threshold" toml:"low_battery_threshold" yaml:"low_battery_threshold"`
+	WeakSignalThreshold          int32             `boil:"weak_signal_threshold" json:"weak_signal_threshold" toml:"weak_signal_threshold" yaml:"weak_signal_threshold"`
+	AlertHysteresis              int32             `boil:"alert_hysteresis" json:"alert_hysteresis" toml:"alert_hysteresis" yaml:"alert_hysteresis"`
+	AlertMode                    string            `boil:"alert_mode" json:"alert_mode" toml:"alert_mode" yaml:"alert_mode"`
+	OfflineNotificationThreshold int32             `boil:"offline_notification_threshold" json:"offline_notification_threshold" toml:"offline_notification_threshold" yaml:"offline_notification_threshold"`
+	DiscoveryInterval            int32             `boil:"discovery_interval" json:"discovery_interval" toml:"discovery_interval" yaml:"discovery_interval"`
+	DiscoveryCron                string            `boil:"discovery_cron" json:"discovery_cron" toml:"discovery_cron" yaml:"discovery_cron"`
+	RefreshCron                  string            `boil:"refresh_cron" json:"refresh_cron" toml:"refresh_cron" yaml:"refresh_cron"`
+	DiscoveryQuietHours          string            `boil:"discovery_quiet_hours" json:"discovery_quiet_hours" toml:"discovery_quiet_hours" yaml:"discovery_quiet_hours"`
+	RingNotifications            bool              `boil:"ring_notifications" json:"ring_notifications" toml:"ring_notifications" yaml:"ring_notifications"`
 
 	R *configurationR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L configurationL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var ConfigurationColumns = struct {
-	ID              string
-	IsLocal         string
-	IsMybuildings   string
-	IsProservice    string
-	ClientID        string
-	ClientSecret    string
-	AccessToken     string
-	RefreshToken    string
-	Expiry          string
-	APIKey          string
-	OrgUUID         string
-	APIURL          string
-	APIUsername     string
-	APIPassword     string
-	RefreshInterval string
-	RequestTimeout  string
-	AssetFilter     string
-	Active          string
-	Enable          string
-	ProjectIds      string
-	UserID          string
+	ID                           string
+	IsLocal                      string
+	IsMybuildings                string
+	IsProservice                 string
+	ClientID                     string
+	ClientSecret                 string
+	AccessToken                  string
+	RefreshToken                 string
+	Expiry                       string
+	APIKey                       string
+	OrgUUID                      string
+	APIURL                       string
+	APIUsername                  string
+	APIPassword                  string
+	RefreshInterval              string
+	RequestTimeout               string
+	AssetFilter                  string
+	Active                       string
+	Enable                       string
+	ProjectIds                   string
+	UserID                       string
+	BackfillThreshold            string
+	BufferSize                   string
+	BufferCompaction             string
+	OrphanGracePeriod            string
+	OrphanPolicy                 string
+	GaiScope                     string
+	RootAssetName                string
+	SystemProjectIds             string
+	DeviceNameTemplate           string
+	ChannelNameTemplate          string
+	DeviceDescriptionTemplate    string
+	ChannelDescriptionTemplate   string
+	Language                     string
+	LowBatteryThreshold          string
+	WeakSignalThreshold          string
+	AlertHysteresis              string
+	AlertMode                    string
+	OfflineNotificationThreshold string
+	DiscoveryInterval            string
+	DiscoveryCron                string
+	RefreshCron                  string
+	DiscoveryQuietHours          string
+	RingNotifications            string
 }{
-	ID:              "id",
-	IsLocal:         "is_local",
-	IsMybuildings:   "is_mybuildings",
-	IsProservice:    "is_proservice",
-	ClientID:        "client_id",
-	ClientSecret:    "client_secret",
-	AccessToken:     "access_token",
-	RefreshToken:    "refresh_token",
-	Expiry:          "expiry",
-	APIKey:          "api_key",
-	OrgUUID:         "org_uuid",
-	APIURL:          "api_url",
-	APIUsername:     "api_username",
-	APIPassword:     "api_password",
-	RefreshInterval: "refresh_interval",
-	RequestTimeout:  "request_timeout",
-	AssetFilter:     "asset_filter",
-	Active:          "active",
-	Enable:          "enable",
-	ProjectIds:      "project_ids",
-	UserID:          "user_id",
+	ID:                           "id",
+	IsLocal:                      "is_local",
+	IsMybuildings:                "is_mybuildings",
+	IsProservice:                 "is_proservice",
+	ClientID:                     "client_id",
+	ClientSecret:                 "client_secret",
+	AccessToken:                  "access_token",
+	RefreshToken:                 "refresh_token",
+	Expiry:                       "expiry",
+	APIKey:                       "api_key",
+	OrgUUID:                      "org_uuid",
+	APIURL:                       "api_url",
+	APIUsername:                  "api_username",
+	APIPassword:                  "api_password",
+	RefreshInterval:              "refresh_interval",
+	RequestTimeout:               "request_timeout",
+	AssetFilter:                  "asset_filter",
+	Active:                       "active",
+	Enable:                       "enable",
+	ProjectIds:                   "project_ids",
+	UserID:                       "user_id",
+	BackfillThreshold:            "backfill_threshold",
+	BufferSize:                   "buffer_size",
+	BufferCompaction:             "buffer_compaction",
+	OrphanGracePeriod:            "orphan_grace_period",
+	OrphanPolicy:                 "orphan_policy",
+	GaiScope:                     "gai_scope",
+	RootAssetName:                "root_asset_name",
+	SystemProjectIds:             "system_project_ids",
+	DeviceNameTemplate:           "device_name_template",
+	ChannelNameTemplate:          "channel_name_template",
+	DeviceDescriptionTemplate:    "device_description_template",
+	ChannelDescriptionTemplate:   "channel_description_template",
+	Language:                     "language",
+	LowBatteryThreshold:          "low_battery_threshold",
+	WeakSignalThreshold:          "weak_signal_threshold",
+	AlertHysteresis:              "alert_hysteresis",
+	AlertMode:                    "alert_mode",
+	OfflineNotificationThreshold: "offline_notification_threshold",
+	DiscoveryInterval:            "discovery_interval",
+	DiscoveryCron:                "discovery_cron",
+	RefreshCron:                  "refresh_cron",
+	DiscoveryQuietHours:          "discovery_quiet_hours",
+	RingNotifications:            "ring_notifications",
 }
 
 var ConfigurationTableColumns = struct {
-	ID              string
-	IsLocal         string
-	IsMybuildings   string
-	IsProservice    string
-	ClientID        string
-	ClientSecret    string
-	AccessToken     string
-	RefreshToken    string
-	Expiry          string
-	APIKey          string
-	OrgUUID         string
-	APIURL          string
-	APIUsername     string
-	APIPassword     string
-	RefreshInterval string
-	RequestTimeout  string
-	AssetFilter     string
-	Active          string
-	Enable          string
-	ProjectIds      string
-	UserID          string
+	ID                           string
+	IsLocal                      string
+	IsMybuildings                string
+	IsProservice                 string
+	ClientID                     string
+	ClientSecret                 string
+	AccessToken                  string
+	RefreshToken                 string
+	Expiry                       string
+	APIKey                       string
+	OrgUUID                      string
+	APIURL                       string
+	APIUsername                  string
+	APIPassword                  string
+	RefreshInterval              string
+	RequestTimeout               string
+	AssetFilter                  string
+	Active                       string
+	Enable                       string
+	ProjectIds                   string
+	UserID                       string
+	BackfillThreshold            string
+	BufferSize                   string
+	BufferCompaction             string
+	OrphanGracePeriod            string
+	OrphanPolicy                 string
+	GaiScope                     string
+	RootAssetName                string
+	SystemProjectIds             string
+	DeviceNameTemplate           string
+	ChannelNameTemplate          string
+	DeviceDescriptionTemplate    string
+	ChannelDescriptionTemplate   string
+	Language                     string
+	LowBatteryThreshold          string
+	WeakSignalThreshold          string
+	AlertHysteresis              string
+	AlertMode                    string
+	OfflineNotificationThreshold string
+	DiscoveryInterval            string
+	DiscoveryCron                string
+	RefreshCron                  string
+	DiscoveryQuietHours          string
+	RingNotifications            string
 }{
-	ID:              "configuration.id",
-	IsLocal:         "configuration.is_local",
-	IsMybuildings:   "configuration.is_mybuildings",
-	IsProservice:    "configuration.is_proservice",
-	ClientID:        "configuration.client_id",
-	ClientSecret:    "configuration.client_secret",
-	AccessToken:     "configuration.access_token",
-	RefreshToken:    "configuration.refresh_token",
-	Expiry:          "configuration.expiry",
-	APIKey:          "configuration.api_key",
-	OrgUUID:         "configuration.org_uuid",
-	APIURL:          "configuration.api_url",
-	APIUsername:     "configuration.api_username",
-	APIPassword:     "configuration.api_password",
-	RefreshInterval: "configuration.refresh_interval",
-	RequestTimeout:  "configuration.request_timeout",
-	AssetFilter:     "configuration.asset_filter",
-	Active:          "configuration.active",
-	Enable:          "configuration.enable",
-	ProjectIds:      "configuration.project_ids",
-	UserID:          "configuration.user_id",
+	ID:                           "configuration.id",
+	IsLocal:                      "configuration.is_local",
+	IsMybuildings:                "configuration.is_mybuildings",
+	IsProservice:                 "configuration.is_proservice",
+	ClientID:                     "configuration.client_id",
+	ClientSecret:                 "configuration.client_secret",
+	AccessToken:                  "configuration.access_token",
+	RefreshToken:                 "configuration.refresh_token",
+	Expiry:                       "configuration.expiry",
+	APIKey:                       "configuration.api_key",
+	OrgUUID:                      "configuration.org_uuid",
+	APIURL:                       "configuration.api_url",
+	APIUsername:                  "configuration.api_username",
+	APIPassword:                  "configuration.api_password",
+	RefreshInterval:              "configuration.refresh_interval",
+	RequestTimeout:               "configuration.request_timeout",
+	AssetFilter:                  "configuration.asset_filter",
+	Active:                       "configuration.active",
+	Enable:                       "configuration.enable",
+	ProjectIds:                   "configuration.project_ids",
+	UserID:                       "configuration.user_id",
+	BackfillThreshold:            "configuration.backfill_threshold",
+	BufferSize:                   "configuration.buffer_size",
+	BufferCompaction:             "configuration.buffer_compaction",
+	OrphanGracePeriod:            "configuration.orphan_grace_period",
+	OrphanPolicy:                 "configuration.orphan_policy",
+	GaiScope:                     "configuration.gai_scope",
+	RootAssetName:                "configuration.root_asset_name",
+	SystemProjectIds:             "configuration.system_project_ids",
+	DeviceNameTemplate:           "configuration.device_name_template",
+	ChannelNameTemplate:          "configuration.channel_name_template",
+	DeviceDescriptionTemplate:    "configuration.device_description_template",
+	ChannelDescriptionTemplate:   "configuration.channel_description_template",
+	Language:                     "configuration.language",
+	LowBatteryThreshold:          "configuration.low_battery_threshold",
+	WeakSignalThreshold:          "configuration.weak_signal_threshold",
+	AlertHysteresis:              "configuration.alert_hysteresis",
+	AlertMode:                    "configuration.alert_mode",
+	OfflineNotificationThreshold: "configuration.offline_notification_threshold",
+	DiscoveryInterval:            "configuration.discovery_interval",
+	DiscoveryCron:                "configuration.discovery_cron",
+	RefreshCron:                  "configuration.refresh_cron",
+	DiscoveryQuietHours:          "configuration.discovery_quiet_hours",
+	RingNotifications:            "configuration.ring_notifications",
 }
 
 // Generated where
@@ -154,103 +269,6 @@ func (w whereHelperbool) LTE(x bool) qm.QueryMod { return qmhelper.Where(w.field
 func (w whereHelperbool) GT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
 func (w whereHelperbool) GTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
 
-type whereHelpernull_String struct{ field string }
-
-func (w whereHelpernull_String) EQ(x null.String) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, false, x)
-}
-func (w whereHelpernull_String) NEQ(x null.String) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, true, x)
-}
-func (w whereHelpernull_String) LT(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpernull_String) LTE(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpernull_String) GT(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpernull_String) GTE(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-func (w whereHelpernull_String) LIKE(x null.String) qm.QueryMod {
-	return qm.Where(w.field+" LIKE ?", x)
-}
-func (w whereHelpernull_String) NLIKE(x null.String) qm.QueryMod {
-	return qm.Where(w.field+" NOT LIKE ?", x)
-}
-func (w whereHelpernull_String) ILIKE(x null.String) qm.QueryMod {
-	return qm.Where(w.field+" ILIKE ?", x)
-}
-func (w whereHelpernull_String) NILIKE(x null.String) qm.QueryMod {
-	return qm.Where(w.field+" NOT ILIKE ?", x)
-}
-func (w whereHelpernull_String) IN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelpernull_String) NIN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
-func (w whereHelpernull_String) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelpernull_String) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
-
-type whereHelpernull_Time struct{ field string }
-
-func (w whereHelpernull_Time) EQ(x null.Time) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, false, x)
-}
-func (w whereHelpernull_Time) NEQ(x null.Time) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, true, x)
-}
-func (w whereHelpernull_Time) LT(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpernull_Time) LTE(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpernull_Time) GT(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpernull_Time) GTE(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-
-func (w whereHelpernull_Time) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelpernull_Time) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
-
-type whereHelperint32 struct{ field string }
-
-func (w whereHelperint32) EQ(x int32) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperint32) NEQ(x int32) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperint32) LT(x int32) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperint32) LTE(x int32) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperint32) GT(x int32) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperint32) GTE(x int32) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperint32) IN(slice []int32) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelperint32) NIN(slice []int32) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
 type whereHelpernull_JSON struct{ field string }
 
 func (w whereHelpernull_JSON) EQ(x null.JSON) qm.QueryMod {
@@ -326,61 +344,122 @@ func (w whereHelpertypes_StringArray) IsNotNull() qm.QueryMod {
 }
 
 var ConfigurationWhere = struct {
-	ID              whereHelperint64
-	IsLocal         whereHelperbool
-	IsMybuildings   whereHelperbool
-	IsProservice    whereHelperbool
-	ClientID        whereHelpernull_String
-	ClientSecret    whereHelpernull_String
-	AccessToken     whereHelpernull_String
-	RefreshToken    whereHelpernull_String
-	Expiry          whereHelpernull_Time
-	APIKey          whereHelpernull_String
-	OrgUUID         whereHelpernull_String
-	APIURL          whereHelpernull_String
-	APIUsername     whereHelpernull_String
-	APIPassword     whereHelpernull_String
-	RefreshInterval whereHelperint32
-	RequestTimeout  whereHelperint32
-	AssetFilter     whereHelpernull_JSON
-	Active          whereHelpernull_Bool
-	Enable          whereHelpernull_Bool
-	ProjectIds      whereHelpertypes_StringArray
-	UserID          whereHelpernull_String
+	ID                           whereHelperint64
+	IsLocal                      whereHelperbool
+	IsMybuildings                whereHelperbool
+	IsProservice                 whereHelperbool
+	ClientID                     whereHelpernull_String
+	ClientSecret                 whereHelpernull_String
+	AccessToken                  whereHelpernull_String
+	RefreshToken                 whereHelpernull_String
+	Expiry                       whereHelpernull_Time
+	APIKey                       whereHelpernull_String
+	OrgUUID                      whereHelpernull_String
+	APIURL                       whereHelpernull_String
+	APIUsername                  whereHelpernull_String
+	APIPassword                  whereHelpernull_String
+	RefreshInterval              whereHelperint32
+	RequestTimeout               whereHelperint32
+	AssetFilter                  whereHelpernull_JSON
+	Active                       whereHelpernull_Bool
+	Enable                       whereHelpernull_Bool
+	ProjectIds                   whereHelpertypes_StringArray
+	UserID                       whereHelpernull_String
+	BackfillThreshold            whereHelperint32
+	BufferSize                   whereHelperint32
+	BufferCompaction             whereHelperbool
+	OrphanGracePeriod            whereHelperint32
+	OrphanPolicy                 whereHelperstring
+	GaiScope                     whereHelpernull_String
+	RootAssetName                whereHelperstring
+	SystemProjectIds             whereHelpernull_JSON
+	DeviceNameTemplate           whereHelperstring
+	ChannelNameTemplate          whereHelperstring
+	DeviceDescriptionTemplate    whereHelperstring
+	ChannelDescriptionTemplate   whereHelperstring
+	Language                     whereHelperstring
+	LowBatteryThreshold          whereHelperint32
+	WeakSignalThreshold          whereHelperint32
+	AlertHysteresis              whereHelperint32
+	AlertMode                    whereHelperstring
+	OfflineNotificationThreshold whereHelperint32
+	DiscoveryInterval            whereHelperint32
+	DiscoveryCron                whereHelperstring
+	RefreshCron                  whereHelperstring
+	DiscoveryQuietHours          whereHelperstring
+	RingNotifications            whereHelperbool
 }{
-	ID:              whereHelperint64{field: "\"abb_free_at_home\".\"configuration\".\"id\""},
-	IsLocal:         whereHelperbool{field: "\"abb_free_at_home\".\"configuration\".\"is_local\""},
-	IsMybuildings:   whereHelperbool{field: "\"abb_free_at_home\".\"configuration\".\"is_mybuildings\""},
-	IsProservice:    whereHelperbool{field: "\"abb_free_at_home\".\"configuration\".\"is_proservice\""},
-	ClientID:        whereHelpernull_String{field: "\"abb_free_at_home\".\"configuration\".\"client_id\""},
-	ClientSecret:    whereHelpernull_String{field: "\"abb_free_at_home\".\"configuration\".\"client_secret\""},
-	AccessToken:     whereHelpernull_String{field: "\"abb_free_at_home\".\"configuration\".\"access_token\""},
-	RefreshToken:    whereHelpernull_String{field: "\"abb_free_at_home\".\"configuration\".\"refresh_token\""},
-	Expiry:          whereHelpernull_Time{field: "\"abb_free_at_home\".\"configuration\".\"expiry\""},
-	APIKey:          whereHelpernull_String{field: "\"abb_free_at_home\".\"configuration\".\"api_key\""},
-	OrgUUID:         whereHelpernull_String{field: "\"abb_free_at_home\".\"configuration\".\"org_uuid\""},
-	APIURL:          whereHelpernull_String{field: "\"abb_free_at_home\".\"configuration\".\"api_url\""},
-	APIUsername:     whereHelpernull_String{field: "\"abb_free_at_home\".\"configuration\".\"api_username\""},
-	APIPassword:     whereHelpernull_String{field: "\"abb_free_at_home\".\"configuration\".\"api_password\""},
-	RefreshInterval: whereHelperint32{field: "\"abb_free_at_home\".\"configuration\".\"refresh_interval\""},
-	RequestTimeout:  whereHelperint32{field: "\"abb_free_at_home\".\"configuration\".\"request_timeout\""},
-	AssetFilter:     whereHelpernull_JSON{field: "\"abb_free_at_home\".\"configuration\".\"asset_filter\""},
-	Active:          whereHelpernull_Bool{field: "\"abb_free_at_home\".\"configuration\".\"active\""},
-	Enable:          whereHelpernull_Bool{field: "\"abb_free_at_home\".\"configuration\".\"enable\""},
-	ProjectIds:      whereHelpertypes_StringArray{field: "\"abb_free_at_home\".\"configuration\".\"project_ids\""},
-	UserID:          whereHelpernull_String{field: "\"abb_free_at_home\".\"configuration\".\"user_id\""},
+	ID:                           whereHelperint64{field: "\"abb_free_at_home\".\"configuration\".\"id\""},
+	IsLocal:                      whereHelperbool{field: "\"abb_free_at_home\".\"configuration\".\"is_local\""},
+	IsMybuildings:                whereHelperbool{field: "\"abb_free_at_home\".\"configuration\".\"is_mybuildings\""},
+	IsProservice:                 whereHelperbool{field: "\"abb_free_at_home\".\"configuration\".\"is_proservice\""},
+	ClientID:                     whereHelpernull_String{field: "\"abb_free_at_home\".\"configuration\".\"client_id\""},
+	ClientSecret:                 whereHelpernull_String{field: "\"abb_free_at_home\".\"configuration\".\"client_secret\""},
+	AccessToken:                  whereHelpernull_String{field: "\"abb_free_at_home\".\"configuration\".\"access_token\""},
+	RefreshToken:                 whereHelpernull_String{field: "\"abb_free_at_home\".\"configuration\".\"refresh_token\""},
+	Expiry:                       whereHelpernull_Time{field: "\"abb_free_at_home\".\"configuration\".\"expiry\""},
+	APIKey:                       whereHelpernull_String{field: "\"abb_free_at_home\".\"configuration\".\"api_key\""},
+	OrgUUID:                      whereHelpernull_String{field: "\"abb_free_at_home\".\"configuration\".\"org_uuid\""},
+	APIURL:                       whereHelpernull_String{field: "\"abb_free_at_home\".\"configuration\".\"api_url\""},
+	APIUsername:                  whereHelpernull_String{field: "\"abb_free_at_home\".\"configuration\".\"api_username\""},
+	APIPassword:                  whereHelpernull_String{field: "\"abb_free_at_home\".\"configuration\".\"api_password\""},
+	RefreshInterval:              whereHelperint32{field: "\"abb_free_at_home\".\"configuration\".\"refresh_interval\""},
+	RequestTimeout:               whereHelperint32{field: "\"abb_free_at_home\".\"configuration\".\"request_timeout\""},
+	AssetFilter:                  whereHelpernull_JSON{field: "\"abb_free_at_home\".\"configuration\".\"asset_filter\""},
+	Active:                       whereHelpernull_Bool{field: "\"abb_free_at_home\".\"configuration\".\"active\""},
+	Enable:                       whereHelpernull_Bool{field: "\"abb_free_at_home\".\"configuration\".\"enable\""},
+	ProjectIds:                   whereHelpertypes_StringArray{field: "\"abb_free_at_home\".\"configuration\".\"project_ids\""},
+	UserID:                       whereHelpernull_String{field: "\"abb_free_at_home\".\"configuration\".\"user_id\""},
+	BackfillThreshold:            whereHelperint32{field: "\"abb_free_at_home\".\"configuration\".\"backfill_threshold\""},
+	BufferSize:                   whereHelperint32{field: "\"abb_free_at_home\".\"configuration\".\"buffer_size\""},
+	BufferCompaction:             whereHelperbool{field: "\"abb_free_at_home\".\"configuration\".\"buffer_compaction\""},
+	OrphanGracePeriod:            whereHelperint32{field: "\"abb_free_at_home\".\"configuration\".\"orphan_grace_period\""},
+	OrphanPolicy:                 whereHelperstring{field: "\"abb_free_at_home\".\"configuration\".\"orphan_policy\""},
+	GaiScope:                     whereHelpernull_String{field: "\"abb_free_at_home\".\"configuration\".\"gai_scope\""},
+	RootAssetName:                whereHelperstring{field: "\"abb_free_at_home\".\"configuration\".\"root_asset_name\""},
+	SystemProjectIds:             whereHelpernull_JSON{field: "\"abb_free_at_home\".\"configuration\".\"system_project_ids\""},
+	DeviceNameTemplate:           whereHelperstring{field: "\"abb_free_at_home\".\"configuration\".\"device_name_template\""},
+	ChannelNameTemplate:          whereHelperstring{field: "\"abb_free_at_home\".\"configuration\".\"channel_name_template\""},
+	DeviceDescriptionTemplate:    whereHelperstring{field: "\"abb_free_at_home\".\"configuration\".\"device_description_template\""},
+	ChannelDescriptionTemplate:   whereHelperstring{field: "\"abb_free_at_home\".\"configuration\".\"channel_description_template\""},
+	Language:                     whereHelperstring{field: "\"abb_free_at_home\".\"configuration\".\"language\""},
+	LowBatteryThreshold:          whereHelperint32{field: "\"abb_free_at_home\".\"configuration\".\"low_battery_threshold\""},
+	WeakSignalThreshold:          whereHelperint32{field: "\"abb_free_at_home\".\"configuration\".\"weak_signal_threshold\""},
+	AlertHysteresis:              whereHelperint32{field: "\"abb_free_at_home\".\"configuration\".\"alert_hysteresis\""},
+	AlertMode:                    whereHelperstring{field: "\"abb_free_at_home\".\"configuration\".\"alert_mode\""},
+	OfflineNotificationThreshold: whereHelperint32{field: "\"abb_free_at_home\".\"configuration\".\"offline_notification_threshold\""},
+	DiscoveryInterval:            whereHelperint32{field: "\"abb_free_at_home\".\"configuration\".\"discovery_interval\""},
+	DiscoveryCron:                whereHelperstring{field: "\"abb_free_at_home\".\"configuration\".\"discovery_cron\""},
+	RefreshCron:                  whereHelperstring{field: "\"abb_free_at_home\".\"configuration\".\"refresh_cron\""},
+	DiscoveryQuietHours:          whereHelperstring{field: "\"abb_free_at_home\".\"configuration\".\"discovery_quiet_hours\""},
+	RingNotifications:            whereHelperbool{field: "\"abb_free_at_home\".\"configuration\".\"ring_notifications\""},
 }
 
 // ConfigurationRels is where relationship names are stored.
 var ConfigurationRels = struct {
-	Assets string
+	SyncWatermark          string
+	Assets                 string
+	BufferedData           string
+	DeviceAlerts           string
+	SyncJobs               string
+	SystemConnectionEvents string
 }{
-	Assets: "Assets",
+	SyncWatermark:          "SyncWatermark",
+	Assets:                 "Assets",
+	BufferedData:           "BufferedData",
+	DeviceAlerts:           "DeviceAlerts",
+	SyncJobs:               "SyncJobs",
+	SystemConnectionEvents: "SystemConnectionEvents",
 }
 
 // configurationR is where relationships are stored.
 type configurationR struct {
-	Assets AssetSlice `boil:"Assets" json:"Assets" toml:"Assets" yaml:"Assets"`
+	SyncWatermark          *SyncWatermark             `boil:"SyncWatermark" json:"SyncWatermark" toml:"SyncWatermark" yaml:"SyncWatermark"`
+	Assets                 AssetSlice                 `boil:"Assets" json:"Assets" toml:"Assets" yaml:"Assets"`
+	BufferedData           BufferedDatumSlice         `boil:"BufferedData" json:"BufferedData" toml:"BufferedData" yaml:"BufferedData"`
+	DeviceAlerts           DeviceAlertSlice           `boil:"DeviceAlerts" json:"DeviceAlerts" toml:"DeviceAlerts" yaml:"DeviceAlerts"`
+	SyncJobs               SyncJobSlice               `boil:"SyncJobs" json:"SyncJobs" toml:"SyncJobs" yaml:"SyncJobs"`
+	SystemConnectionEvents SystemConnectionEventSlice `boil:"SystemConnectionEvents" json:"SystemConnectionEvents" toml:"SystemConnectionEvents" yaml:"SystemConnectionEvents"`
 }
 
 // NewStruct creates a new relationship struct
@@ -388,6 +467,13 @@ func (*configurationR) NewStruct() *configurationR {
 	return &configurationR{}
 }
 
+func (r *configurationR) GetSyncWatermark() *SyncWatermark {
+	if r == nil {
+		return nil
+	}
+	return r.SyncWatermark
+}
+
 func (r *configurationR) GetAssets() AssetSlice {
 	if r == nil {
 		return nil
@@ -395,13 +481,41 @@ func (r *configurationR) GetAssets() AssetSlice {
 	return r.Assets
 }
 
+func (r *configurationR) GetBufferedData() BufferedDatumSlice {
+	if r == nil {
+		return nil
+	}
+	return r.BufferedData
+}
+
+func (r *configurationR) GetDeviceAlerts() DeviceAlertSlice {
+	if r == nil {
+		return nil
+	}
+	return r.DeviceAlerts
+}
+
+func (r *configurationR) GetSyncJobs() SyncJobSlice {
+	if r == nil {
+		return nil
+	}
+	return r.SyncJobs
+}
+
+func (r *configurationR) GetSystemConnectionEvents() SystemConnectionEventSlice {
+	if r == nil {
+		return nil
+	}
+	return r.SystemConnectionEvents
+}
+
 // configurationL is where Load methods for each relationship are stored.
 type configurationL struct{}
 
 var (
-	configurationAllColumns            = []string{"id", "is_local", "is_mybuildings", "is_proservice", "client_id", "client_secret", "access_token", "refresh_token", "expiry", "api_key", "org_uuid", "api_url", "api_username", "api_password", "refresh_interval", "request_timeout", "asset_filter", "active", "enable", "project_ids", "user_id"}
+	configurationAllColumns            = []string{"id", "is_local", "is_mybuildings", "is_proservice", "client_id", "client_secret", "access_token", "refresh_token", "expiry", "api_key", "org_uuid", "api_url", "api_username", "api_password", "refresh_interval", "request_timeout", "asset_filter", "active", "enable", "project_ids", "user_id", "backfill_threshold", "buffer_size", "buffer_compaction", "orphan_grace_period", "orphan_policy", "gai_scope", "root_asset_name", "system_project_ids", "device_name_template", "channel_name_template", "device_description_template", "channel_description_template", "language", "low_battery_threshold", "weak_signal_threshold", "alert_hysteresis", "alert_mode", "offline_notification_threshold", "discovery_interval", "discovery_cron", "refresh_cron", "discovery_quiet_hours", "ring_notifications"}
 	configurationColumnsWithoutDefault = []string{}
-	configurationColumnsWithDefault    = []string{"id", "is_local", "is_mybuildings", "is_proservice", "client_id", "client_secret", "access_token", "refresh_token", "expiry", "api_key", "org_uuid", "api_url", "api_username", "api_password", "refresh_interval", "request_timeout", "asset_filter", "active", "enable", "project_ids", "user_id"}
+	configurationColumnsWithDefault    = []string{"id", "is_local", "is_mybuildings", "is_proservice", "client_id", "client_secret", "access_token", "refresh_token", "expiry", "api_key", "org_uuid", "api_url", "api_username", "api_password", "refresh_interval", "request_timeout", "asset_filter", "active", "enable", "project_ids", "user_id", "backfill_threshold", "buffer_size", "buffer_compaction", "orphan_grace_period", "orphan_policy", "gai_scope", "root_asset_name", "system_project_ids", "device_name_template", "channel_name_template", "device_description_template", "channel_description_template", "language", "low_battery_threshold", "weak_signal_threshold", "alert_hysteresis", "alert_mode", "offline_notification_threshold", "discovery_interval", "discovery_cron", "refresh_cron", "discovery_quiet_hours", "ring_notifications"}
 	configurationPrimaryKeyColumns     = []string{"id"}
 	configurationGeneratedColumns      = []string{}
 )
@@ -731,6 +845,17 @@ func (q configurationQuery) Exists(ctx context.Context, exec boil.ContextExecuto
 	return count > 0, nil
 }
 
+// SyncWatermark pointed to by the foreign key.
+func (o *Configuration) SyncWatermark(mods ...qm.QueryMod) syncWatermarkQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"configuration_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return SyncWatermarks(queryMods...)
+}
+
 // Assets retrieves all the asset's Assets with an executor.
 func (o *Configuration) Assets(mods ...qm.QueryMod) assetQuery {
 	var queryMods []qm.QueryMod
@@ -745,6 +870,179 @@ func (o *Configuration) Assets(mods ...qm.QueryMod) assetQuery {
 	return Assets(queryMods...)
 }
 
+// BufferedData retrieves all the buffered_datum's BufferedData with an executor.
+func (o *Configuration) BufferedData(mods ...qm.QueryMod) bufferedDatumQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"abb_free_at_home\".\"buffered_data\".\"configuration_id\"=?", o.ID),
+	)
+
+	return BufferedData(queryMods...)
+}
+
+// DeviceAlerts retrieves all the device_alert's DeviceAlerts with an executor.
+func (o *Configuration) DeviceAlerts(mods ...qm.QueryMod) deviceAlertQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"abb_free_at_home\".\"device_alert\".\"configuration_id\"=?", o.ID),
+	)
+
+	return DeviceAlerts(queryMods...)
+}
+
+// SyncJobs retrieves all the sync_job's SyncJobs with an executor.
+func (o *Configuration) SyncJobs(mods ...qm.QueryMod) syncJobQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"abb_free_at_home\".\"sync_job\".\"configuration_id\"=?", o.ID),
+	)
+
+	return SyncJobs(queryMods...)
+}
+
+// SystemConnectionEvents retrieves all the system_connection_event's SystemConnectionEvents with an executor.
+func (o *Configuration) SystemConnectionEvents(mods ...qm.QueryMod) systemConnectionEventQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"abb_free_at_home\".\"system_connection_event\".\"configuration_id\"=?", o.ID),
+	)
+
+	return SystemConnectionEvents(queryMods...)
+}
+
+// LoadSyncWatermark allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (configurationL) LoadSyncWatermark(ctx context.Context, e boil.ContextExecutor, singular bool, maybeConfiguration interface{}, mods queries.Applicator) error {
+	var slice []*Configuration
+	var object *Configuration
+
+	if singular {
+		var ok bool
+		object, ok = maybeConfiguration.(*Configuration)
+		if !ok {
+			object = new(Configuration)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeConfiguration)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeConfiguration))
+			}
+		}
+	} else {
+		s, ok := maybeConfiguration.(*[]*Configuration)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeConfiguration)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeConfiguration))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &configurationR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &configurationR{}
+			}
+
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`abb_free_at_home.sync_watermark`),
+		qm.WhereIn(`abb_free_at_home.sync_watermark.configuration_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load SyncWatermark")
+	}
+
+	var resultSlice []*SyncWatermark
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice SyncWatermark")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for sync_watermark")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for sync_watermark")
+	}
+
+	if len(syncWatermarkAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.SyncWatermark = foreign
+		if foreign.R == nil {
+			foreign.R = &syncWatermarkR{}
+		}
+		foreign.R.Configuration = object
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ID == foreign.ConfigurationID {
+				local.R.SyncWatermark = foreign
+				if foreign.R == nil {
+					foreign.R = &syncWatermarkR{}
+				}
+				foreign.R.Configuration = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadAssets allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (configurationL) LoadAssets(ctx context.Context, e boil.ContextExecutor, singular bool, maybeConfiguration interface{}, mods queries.Applicator) error {
@@ -858,38 +1156,548 @@ func (configurationL) LoadAssets(ctx context.Context, e boil.ContextExecutor, si
 	return nil
 }
 
-// AddAssetsG adds the given related objects to the existing relationships
-// of the configuration, optionally inserting them as new records.
-// Appends related to o.R.Assets.
-// Sets related.R.Configuration appropriately.
-// Uses the global database handle.
-func (o *Configuration) AddAssetsG(ctx context.Context, insert bool, related ...*Asset) error {
-	return o.AddAssets(ctx, boil.GetContextDB(), insert, related...)
-}
+// LoadBufferedData allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (configurationL) LoadBufferedData(ctx context.Context, e boil.ContextExecutor, singular bool, maybeConfiguration interface{}, mods queries.Applicator) error {
+	var slice []*Configuration
+	var object *Configuration
 
-// AddAssets adds the given related objects to the existing relationships
-// of the configuration, optionally inserting them as new records.
-// Appends related to o.R.Assets.
-// Sets related.R.Configuration appropriately.
-func (o *Configuration) AddAssets(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Asset) error {
-	var err error
-	for _, rel := range related {
-		if insert {
-			rel.ConfigurationID = o.ID
-			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
-				return errors.Wrap(err, "failed to insert into foreign table")
+	if singular {
+		var ok bool
+		object, ok = maybeConfiguration.(*Configuration)
+		if !ok {
+			object = new(Configuration)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeConfiguration)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeConfiguration))
 			}
+		}
+	} else {
+		s, ok := maybeConfiguration.(*[]*Configuration)
+		if ok {
+			slice = *s
 		} else {
-			updateQuery := fmt.Sprintf(
-				"UPDATE \"abb_free_at_home\".\"asset\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"configuration_id"}),
-				strmangle.WhereClause("\"", "\"", 2, assetPrimaryKeyColumns),
-			)
-			values := []interface{}{o.ID, rel.ID}
-
-			if boil.IsDebug(ctx) {
-				writer := boil.DebugWriterFrom(ctx)
-				fmt.Fprintln(writer, updateQuery)
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeConfiguration)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeConfiguration))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &configurationR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &configurationR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`abb_free_at_home.buffered_data`),
+		qm.WhereIn(`abb_free_at_home.buffered_data.configuration_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load buffered_data")
+	}
+
+	var resultSlice []*BufferedDatum
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice buffered_data")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on buffered_data")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for buffered_data")
+	}
+
+	if len(bufferedDatumAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.BufferedData = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &bufferedDatumR{}
+			}
+			foreign.R.Configuration = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ConfigurationID {
+				local.R.BufferedData = append(local.R.BufferedData, foreign)
+				if foreign.R == nil {
+					foreign.R = &bufferedDatumR{}
+				}
+				foreign.R.Configuration = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadDeviceAlerts allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (configurationL) LoadDeviceAlerts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeConfiguration interface{}, mods queries.Applicator) error {
+	var slice []*Configuration
+	var object *Configuration
+
+	if singular {
+		var ok bool
+		object, ok = maybeConfiguration.(*Configuration)
+		if !ok {
+			object = new(Configuration)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeConfiguration)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeConfiguration))
+			}
+		}
+	} else {
+		s, ok := maybeConfiguration.(*[]*Configuration)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeConfiguration)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeConfiguration))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &configurationR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &configurationR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`abb_free_at_home.device_alert`),
+		qm.WhereIn(`abb_free_at_home.device_alert.configuration_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load device_alert")
+	}
+
+	var resultSlice []*DeviceAlert
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice device_alert")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on device_alert")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for device_alert")
+	}
+
+	if len(deviceAlertAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.DeviceAlerts = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &deviceAlertR{}
+			}
+			foreign.R.Configuration = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ConfigurationID {
+				local.R.DeviceAlerts = append(local.R.DeviceAlerts, foreign)
+				if foreign.R == nil {
+					foreign.R = &deviceAlertR{}
+				}
+				foreign.R.Configuration = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSyncJobs allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (configurationL) LoadSyncJobs(ctx context.Context, e boil.ContextExecutor, singular bool, maybeConfiguration interface{}, mods queries.Applicator) error {
+	var slice []*Configuration
+	var object *Configuration
+
+	if singular {
+		var ok bool
+		object, ok = maybeConfiguration.(*Configuration)
+		if !ok {
+			object = new(Configuration)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeConfiguration)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeConfiguration))
+			}
+		}
+	} else {
+		s, ok := maybeConfiguration.(*[]*Configuration)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeConfiguration)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeConfiguration))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &configurationR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &configurationR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`abb_free_at_home.sync_job`),
+		qm.WhereIn(`abb_free_at_home.sync_job.configuration_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load sync_job")
+	}
+
+	var resultSlice []*SyncJob
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice sync_job")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on sync_job")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for sync_job")
+	}
+
+	if len(syncJobAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.SyncJobs = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &syncJobR{}
+			}
+			foreign.R.Configuration = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ConfigurationID {
+				local.R.SyncJobs = append(local.R.SyncJobs, foreign)
+				if foreign.R == nil {
+					foreign.R = &syncJobR{}
+				}
+				foreign.R.Configuration = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSystemConnectionEvents allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (configurationL) LoadSystemConnectionEvents(ctx context.Context, e boil.ContextExecutor, singular bool, maybeConfiguration interface{}, mods queries.Applicator) error {
+	var slice []*Configuration
+	var object *Configuration
+
+	if singular {
+		var ok bool
+		object, ok = maybeConfiguration.(*Configuration)
+		if !ok {
+			object = new(Configuration)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeConfiguration)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeConfiguration))
+			}
+		}
+	} else {
+		s, ok := maybeConfiguration.(*[]*Configuration)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeConfiguration)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeConfiguration))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &configurationR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &configurationR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`abb_free_at_home.system_connection_event`),
+		qm.WhereIn(`abb_free_at_home.system_connection_event.configuration_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load system_connection_event")
+	}
+
+	var resultSlice []*SystemConnectionEvent
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice system_connection_event")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on system_connection_event")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for system_connection_event")
+	}
+
+	if len(systemConnectionEventAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.SystemConnectionEvents = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &systemConnectionEventR{}
+			}
+			foreign.R.Configuration = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ConfigurationID {
+				local.R.SystemConnectionEvents = append(local.R.SystemConnectionEvents, foreign)
+				if foreign.R == nil {
+					foreign.R = &systemConnectionEventR{}
+				}
+				foreign.R.Configuration = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetSyncWatermarkG of the configuration to the related item.
+// Sets o.R.SyncWatermark to related.
+// Adds o to related.R.Configuration.
+// Uses the global database handle.
+func (o *Configuration) SetSyncWatermarkG(ctx context.Context, insert bool, related *SyncWatermark) error {
+	return o.SetSyncWatermark(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetSyncWatermark of the configuration to the related item.
+// Sets o.R.SyncWatermark to related.
+// Adds o to related.R.Configuration.
+func (o *Configuration) SetSyncWatermark(ctx context.Context, exec boil.ContextExecutor, insert bool, related *SyncWatermark) error {
+	var err error
+
+	if insert {
+		related.ConfigurationID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"abb_free_at_home\".\"sync_watermark\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, []string{"configuration_id"}),
+			strmangle.WhereClause("\"", "\"", 2, syncWatermarkPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.ConfigurationID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.ConfigurationID = o.ID
+	}
+
+	if o.R == nil {
+		o.R = &configurationR{
+			SyncWatermark: related,
+		}
+	} else {
+		o.R.SyncWatermark = related
+	}
+
+	if related.R == nil {
+		related.R = &syncWatermarkR{
+			Configuration: o,
+		}
+	} else {
+		related.R.Configuration = o
+	}
+	return nil
+}
+
+// AddAssetsG adds the given related objects to the existing relationships
+// of the configuration, optionally inserting them as new records.
+// Appends related to o.R.Assets.
+// Sets related.R.Configuration appropriately.
+// Uses the global database handle.
+func (o *Configuration) AddAssetsG(ctx context.Context, insert bool, related ...*Asset) error {
+	return o.AddAssets(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddAssets adds the given related objects to the existing relationships
+// of the configuration, optionally inserting them as new records.
+// Appends related to o.R.Assets.
+// Sets related.R.Configuration appropriately.
+func (o *Configuration) AddAssets(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Asset) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ConfigurationID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"abb_free_at_home\".\"asset\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"configuration_id"}),
+				strmangle.WhereClause("\"", "\"", 2, assetPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
 				fmt.Fprintln(writer, values)
 			}
 			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
@@ -920,6 +1728,254 @@ func (o *Configuration) AddAssets(ctx context.Context, exec boil.ContextExecutor
 	return nil
 }
 
+// AddBufferedDataG adds the given related objects to the existing relationships
+// of the configuration, optionally inserting them as new records.
+// Appends related to o.R.BufferedData.
+// Sets related.R.Configuration appropriately.
+// Uses the global database handle.
+func (o *Configuration) AddBufferedDataG(ctx context.Context, insert bool, related ...*BufferedDatum) error {
+	return o.AddBufferedData(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddBufferedData adds the given related objects to the existing relationships
+// of the configuration, optionally inserting them as new records.
+// Appends related to o.R.BufferedData.
+// Sets related.R.Configuration appropriately.
+func (o *Configuration) AddBufferedData(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*BufferedDatum) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ConfigurationID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"abb_free_at_home\".\"buffered_data\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"configuration_id"}),
+				strmangle.WhereClause("\"", "\"", 2, bufferedDatumPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ConfigurationID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &configurationR{
+			BufferedData: related,
+		}
+	} else {
+		o.R.BufferedData = append(o.R.BufferedData, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &bufferedDatumR{
+				Configuration: o,
+			}
+		} else {
+			rel.R.Configuration = o
+		}
+	}
+	return nil
+}
+
+// AddDeviceAlertsG adds the given related objects to the existing relationships
+// of the configuration, optionally inserting them as new records.
+// Appends related to o.R.DeviceAlerts.
+// Sets related.R.Configuration appropriately.
+// Uses the global database handle.
+func (o *Configuration) AddDeviceAlertsG(ctx context.Context, insert bool, related ...*DeviceAlert) error {
+	return o.AddDeviceAlerts(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddDeviceAlerts adds the given related objects to the existing relationships
+// of the configuration, optionally inserting them as new records.
+// Appends related to o.R.DeviceAlerts.
+// Sets related.R.Configuration appropriately.
+func (o *Configuration) AddDeviceAlerts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*DeviceAlert) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ConfigurationID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"abb_free_at_home\".\"device_alert\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"configuration_id"}),
+				strmangle.WhereClause("\"", "\"", 2, deviceAlertPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ConfigurationID, rel.DeviceGai, rel.Kind}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ConfigurationID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &configurationR{
+			DeviceAlerts: related,
+		}
+	} else {
+		o.R.DeviceAlerts = append(o.R.DeviceAlerts, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &deviceAlertR{
+				Configuration: o,
+			}
+		} else {
+			rel.R.Configuration = o
+		}
+	}
+	return nil
+}
+
+// AddSyncJobsG adds the given related objects to the existing relationships
+// of the configuration, optionally inserting them as new records.
+// Appends related to o.R.SyncJobs.
+// Sets related.R.Configuration appropriately.
+// Uses the global database handle.
+func (o *Configuration) AddSyncJobsG(ctx context.Context, insert bool, related ...*SyncJob) error {
+	return o.AddSyncJobs(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddSyncJobs adds the given related objects to the existing relationships
+// of the configuration, optionally inserting them as new records.
+// Appends related to o.R.SyncJobs.
+// Sets related.R.Configuration appropriately.
+func (o *Configuration) AddSyncJobs(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*SyncJob) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ConfigurationID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"abb_free_at_home\".\"sync_job\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"configuration_id"}),
+				strmangle.WhereClause("\"", "\"", 2, syncJobPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ConfigurationID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &configurationR{
+			SyncJobs: related,
+		}
+	} else {
+		o.R.SyncJobs = append(o.R.SyncJobs, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &syncJobR{
+				Configuration: o,
+			}
+		} else {
+			rel.R.Configuration = o
+		}
+	}
+	return nil
+}
+
+// AddSystemConnectionEventsG adds the given related objects to the existing relationships
+// of the configuration, optionally inserting them as new records.
+// Appends related to o.R.SystemConnectionEvents.
+// Sets related.R.Configuration appropriately.
+// Uses the global database handle.
+func (o *Configuration) AddSystemConnectionEventsG(ctx context.Context, insert bool, related ...*SystemConnectionEvent) error {
+	return o.AddSystemConnectionEvents(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddSystemConnectionEvents adds the given related objects to the existing relationships
+// of the configuration, optionally inserting them as new records.
+// Appends related to o.R.SystemConnectionEvents.
+// Sets related.R.Configuration appropriately.
+func (o *Configuration) AddSystemConnectionEvents(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*SystemConnectionEvent) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ConfigurationID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"abb_free_at_home\".\"system_connection_event\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"configuration_id"}),
+				strmangle.WhereClause("\"", "\"", 2, systemConnectionEventPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ConfigurationID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &configurationR{
+			SystemConnectionEvents: related,
+		}
+	} else {
+		o.R.SystemConnectionEvents = append(o.R.SystemConnectionEvents, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &systemConnectionEventR{
+				Configuration: o,
+			}
+		} else {
+			rel.R.Configuration = o
+		}
+	}
+	return nil
+}
+
 // Configurations retrieves all the records using an executor.
 func Configurations(mods ...qm.QueryMod) configurationQuery {
 	mods = append(mods, qm.From("\"abb_free_at_home\".\"configuration\""))
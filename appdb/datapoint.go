@@ -34,6 +34,7 @@ type Datapoint struct {
 	IsInput          bool         `boil:"is_input" json:"is_input" toml:"is_input" yaml:"is_input"`
 	LastWrittenValue null.Float64 `boil:"last_written_value" json:"last_written_value,omitempty" toml:"last_written_value" yaml:"last_written_value,omitempty"`
 	LastWrittenTime  null.Time    `boil:"last_written_time" json:"last_written_time,omitempty" toml:"last_written_time" yaml:"last_written_time,omitempty"`
+	DPT              null.String  `boil:"dpt" json:"dpt,omitempty" toml:"dpt" yaml:"dpt,omitempty"`
 
 	R *datapointR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L datapointL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -50,6 +51,7 @@ var DatapointColumns = struct {
 	IsInput          string
 	LastWrittenValue string
 	LastWrittenTime  string
+	DPT              string
 }{
 	ID:               "id",
 	AssetID:          "asset_id",
@@ -61,6 +63,7 @@ var DatapointColumns = struct {
 	IsInput:          "is_input",
 	LastWrittenValue: "last_written_value",
 	LastWrittenTime:  "last_written_time",
+	DPT:              "dpt",
 }
 
 var DatapointTableColumns = struct {
@@ -74,6 +77,7 @@ var DatapointTableColumns = struct {
 	IsInput          string
 	LastWrittenValue string
 	LastWrittenTime  string
+	DPT              string
 }{
 	ID:               "datapoint.id",
 	AssetID:          "datapoint.asset_id",
@@ -85,6 +89,7 @@ var DatapointTableColumns = struct {
 	IsInput:          "datapoint.is_input",
 	LastWrittenValue: "datapoint.last_written_value",
 	LastWrittenTime:  "datapoint.last_written_time",
+	DPT:              "datapoint.dpt",
 }
 
 // Generated where
@@ -138,6 +143,7 @@ var DatapointWhere = struct {
 	IsInput          whereHelperbool
 	LastWrittenValue whereHelpernull_Float64
 	LastWrittenTime  whereHelpernull_Time
+	DPT              whereHelpernull_String
 }{
 	ID:               whereHelperint64{field: "\"abb_free_at_home\".\"datapoint\".\"id\""},
 	AssetID:          whereHelperint32{field: "\"abb_free_at_home\".\"datapoint\".\"asset_id\""},
@@ -149,20 +155,24 @@ var DatapointWhere = struct {
 	IsInput:          whereHelperbool{field: "\"abb_free_at_home\".\"datapoint\".\"is_input\""},
 	LastWrittenValue: whereHelpernull_Float64{field: "\"abb_free_at_home\".\"datapoint\".\"last_written_value\""},
 	LastWrittenTime:  whereHelpernull_Time{field: "\"abb_free_at_home\".\"datapoint\".\"last_written_time\""},
+	DPT:              whereHelpernull_String{field: "\"abb_free_at_home\".\"datapoint\".\"dpt\""},
 }
 
 // DatapointRels is where relationship names are stored.
 var DatapointRels = struct {
 	Asset               string
+	DatapointWatermark  string
 	DatapointAttributes string
 }{
 	Asset:               "Asset",
+	DatapointWatermark:  "DatapointWatermark",
 	DatapointAttributes: "DatapointAttributes",
 }
 
 // datapointR is where relationships are stored.
 type datapointR struct {
 	Asset               *Asset                  `boil:"Asset" json:"Asset" toml:"Asset" yaml:"Asset"`
+	DatapointWatermark  *DatapointWatermark     `boil:"DatapointWatermark" json:"DatapointWatermark" toml:"DatapointWatermark" yaml:"DatapointWatermark"`
 	DatapointAttributes DatapointAttributeSlice `boil:"DatapointAttributes" json:"DatapointAttributes" toml:"DatapointAttributes" yaml:"DatapointAttributes"`
 }
 
@@ -178,6 +188,13 @@ func (r *datapointR) GetAsset() *Asset {
 	return r.Asset
 }
 
+func (r *datapointR) GetDatapointWatermark() *DatapointWatermark {
+	if r == nil {
+		return nil
+	}
+	return r.DatapointWatermark
+}
+
 func (r *datapointR) GetDatapointAttributes() DatapointAttributeSlice {
 	if r == nil {
 		return nil
@@ -189,9 +206,9 @@ func (r *datapointR) GetDatapointAttributes() DatapointAttributeSlice {
 type datapointL struct{}
 
 var (
-	datapointAllColumns            = []string{"id", "asset_id", "system_id", "device_id", "channel_id", "datapoint", "function", "is_input", "last_written_value", "last_written_time"}
+	datapointAllColumns            = []string{"id", "asset_id", "system_id", "device_id", "channel_id", "datapoint", "function", "is_input", "last_written_value", "last_written_time", "dpt"}
 	datapointColumnsWithoutDefault = []string{"asset_id", "system_id", "device_id", "channel_id", "datapoint", "function", "is_input"}
-	datapointColumnsWithDefault    = []string{"id", "last_written_value", "last_written_time"}
+	datapointColumnsWithDefault    = []string{"id", "last_written_value", "last_written_time", "dpt"}
 	datapointPrimaryKeyColumns     = []string{"id"}
 	datapointGeneratedColumns      = []string{}
 )
@@ -532,6 +549,17 @@ func (o *Datapoint) Asset(mods ...qm.QueryMod) assetQuery {
 	return Assets(queryMods...)
 }
 
+// DatapointWatermark pointed to by the foreign key.
+func (o *Datapoint) DatapointWatermark(mods ...qm.QueryMod) datapointWatermarkQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"datapoint_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return DatapointWatermarks(queryMods...)
+}
+
 // DatapointAttributes retrieves all the datapoint_attribute's DatapointAttributes with an executor.
 func (o *Datapoint) DatapointAttributes(mods ...qm.QueryMod) datapointAttributeQuery {
 	var queryMods []qm.QueryMod
@@ -670,6 +698,123 @@ func (datapointL) LoadAsset(ctx context.Context, e boil.ContextExecutor, singula
 	return nil
 }
 
+// LoadDatapointWatermark allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (datapointL) LoadDatapointWatermark(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDatapoint interface{}, mods queries.Applicator) error {
+	var slice []*Datapoint
+	var object *Datapoint
+
+	if singular {
+		var ok bool
+		object, ok = maybeDatapoint.(*Datapoint)
+		if !ok {
+			object = new(Datapoint)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeDatapoint)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeDatapoint))
+			}
+		}
+	} else {
+		s, ok := maybeDatapoint.(*[]*Datapoint)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeDatapoint)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeDatapoint))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &datapointR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &datapointR{}
+			}
+
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`abb_free_at_home.datapoint_watermark`),
+		qm.WhereIn(`abb_free_at_home.datapoint_watermark.datapoint_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load DatapointWatermark")
+	}
+
+	var resultSlice []*DatapointWatermark
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice DatapointWatermark")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for datapoint_watermark")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for datapoint_watermark")
+	}
+
+	if len(datapointWatermarkAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.DatapointWatermark = foreign
+		if foreign.R == nil {
+			foreign.R = &datapointWatermarkR{}
+		}
+		foreign.R.Datapoint = object
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ID == foreign.DatapointID {
+				local.R.DatapointWatermark = foreign
+				if foreign.R == nil {
+					foreign.R = &datapointWatermarkR{}
+				}
+				foreign.R.Datapoint = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadDatapointAttributes allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (datapointL) LoadDatapointAttributes(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDatapoint interface{}, mods queries.Applicator) error {
@@ -838,6 +983,64 @@ func (o *Datapoint) SetAsset(ctx context.Context, exec boil.ContextExecutor, ins
 	return nil
 }
 
+// SetDatapointWatermarkG of the datapoint to the related item.
+// Sets o.R.DatapointWatermark to related.
+// Adds o to related.R.Datapoint.
+// Uses the global database handle.
+func (o *Datapoint) SetDatapointWatermarkG(ctx context.Context, insert bool, related *DatapointWatermark) error {
+	return o.SetDatapointWatermark(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetDatapointWatermark of the datapoint to the related item.
+// Sets o.R.DatapointWatermark to related.
+// Adds o to related.R.Datapoint.
+func (o *Datapoint) SetDatapointWatermark(ctx context.Context, exec boil.ContextExecutor, insert bool, related *DatapointWatermark) error {
+	var err error
+
+	if insert {
+		related.DatapointID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"abb_free_at_home\".\"datapoint_watermark\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, []string{"datapoint_id"}),
+			strmangle.WhereClause("\"", "\"", 2, datapointWatermarkPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.DatapointID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.DatapointID = o.ID
+	}
+
+	if o.R == nil {
+		o.R = &datapointR{
+			DatapointWatermark: related,
+		}
+	} else {
+		o.R.DatapointWatermark = related
+	}
+
+	if related.R == nil {
+		related.R = &datapointWatermarkR{
+			Datapoint: o,
+		}
+	} else {
+		related.R.Datapoint = o
+	}
+	return nil
+}
+
 // AddDatapointAttributesG adds the given related objects to the existing relationships
 // of the datapoint, optionally inserting them as new records.
 // Appends related to o.R.DatapointAttributes.
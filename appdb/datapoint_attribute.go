@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
@@ -23,51 +24,58 @@ import (
 
 // DatapointAttribute is an object representing the database table.
 type DatapointAttribute struct {
-	ID            int64  `boil:"id" json:"id" toml:"id" yaml:"id"`
-	DatapointID   int64  `boil:"datapoint_id" json:"datapoint_id" toml:"datapoint_id" yaml:"datapoint_id"`
-	Subtype       string `boil:"subtype" json:"subtype" toml:"subtype" yaml:"subtype"`
-	AttributeName string `boil:"attribute_name" json:"attribute_name" toml:"attribute_name" yaml:"attribute_name"`
+	ID             int64     `boil:"id" json:"id" toml:"id" yaml:"id"`
+	DatapointID    int64     `boil:"datapoint_id" json:"datapoint_id" toml:"datapoint_id" yaml:"datapoint_id"`
+	Subtype        string    `boil:"subtype" json:"subtype" toml:"subtype" yaml:"subtype"`
+	AttributeName  string    `boil:"attribute_name" json:"attribute_name" toml:"attribute_name" yaml:"attribute_name"`
+	Transformation null.JSON `boil:"transformation" json:"transformation,omitempty" toml:"transformation" yaml:"transformation,omitempty"`
 
 	R *datapointAttributeR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L datapointAttributeL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var DatapointAttributeColumns = struct {
-	ID            string
-	DatapointID   string
-	Subtype       string
-	AttributeName string
+	ID             string
+	DatapointID    string
+	Subtype        string
+	AttributeName  string
+	Transformation string
 }{
-	ID:            "id",
-	DatapointID:   "datapoint_id",
-	Subtype:       "subtype",
-	AttributeName: "attribute_name",
+	ID:             "id",
+	DatapointID:    "datapoint_id",
+	Subtype:        "subtype",
+	AttributeName:  "attribute_name",
+	Transformation: "transformation",
 }
 
 var DatapointAttributeTableColumns = struct {
-	ID            string
-	DatapointID   string
-	Subtype       string
-	AttributeName string
+	ID             string
+	DatapointID    string
+	Subtype        string
+	AttributeName  string
+	Transformation string
 }{
-	ID:            "datapoint_attribute.id",
-	DatapointID:   "datapoint_attribute.datapoint_id",
-	Subtype:       "datapoint_attribute.subtype",
-	AttributeName: "datapoint_attribute.attribute_name",
+	ID:             "datapoint_attribute.id",
+	DatapointID:    "datapoint_attribute.datapoint_id",
+	Subtype:        "datapoint_attribute.subtype",
+	AttributeName:  "datapoint_attribute.attribute_name",
+	Transformation: "datapoint_attribute.transformation",
 }
 
 // Generated where
 
 var DatapointAttributeWhere = struct {
-	ID            whereHelperint64
-	DatapointID   whereHelperint64
-	Subtype       whereHelperstring
-	AttributeName whereHelperstring
+	ID             whereHelperint64
+	DatapointID    whereHelperint64
+	Subtype        whereHelperstring
+	AttributeName  whereHelperstring
+	Transformation whereHelpernull_JSON
 }{
-	ID:            whereHelperint64{field: "\"abb_free_at_home\".\"datapoint_attribute\".\"id\""},
-	DatapointID:   whereHelperint64{field: "\"abb_free_at_home\".\"datapoint_attribute\".\"datapoint_id\""},
-	Subtype:       whereHelperstring{field: "\"abb_free_at_home\".\"datapoint_attribute\".\"subtype\""},
-	AttributeName: whereHelperstring{field: "\"abb_free_at_home\".\"datapoint_attribute\".\"attribute_name\""},
+	ID:             whereHelperint64{field: "\"abb_free_at_home\".\"datapoint_attribute\".\"id\""},
+	DatapointID:    whereHelperint64{field: "\"abb_free_at_home\".\"datapoint_attribute\".\"datapoint_id\""},
+	Subtype:        whereHelperstring{field: "\"abb_free_at_home\".\"datapoint_attribute\".\"subtype\""},
+	AttributeName:  whereHelperstring{field: "\"abb_free_at_home\".\"datapoint_attribute\".\"attribute_name\""},
+	Transformation: whereHelpernull_JSON{field: "\"abb_free_at_home\".\"datapoint_attribute\".\"transformation\""},
 }
 
 // DatapointAttributeRels is where relationship names are stored.
@@ -98,9 +106,9 @@ func (r *datapointAttributeR) GetDatapoint() *Datapoint {
 type datapointAttributeL struct{}
 
 var (
-	datapointAttributeAllColumns            = []string{"id", "datapoint_id", "subtype", "attribute_name"}
+	datapointAttributeAllColumns            = []string{"id", "datapoint_id", "subtype", "attribute_name", "transformation"}
 	datapointAttributeColumnsWithoutDefault = []string{"subtype", "attribute_name"}
-	datapointAttributeColumnsWithDefault    = []string{"id", "datapoint_id"}
+	datapointAttributeColumnsWithDefault    = []string{"id", "datapoint_id", "transformation"}
 	datapointAttributePrimaryKeyColumns     = []string{"id"}
 	datapointAttributeGeneratedColumns      = []string{}
 )
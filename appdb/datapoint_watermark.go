@@ -0,0 +1,1215 @@
+// Code generated by SQLBoiler 4.16.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package appdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// DatapointWatermark is an object representing the database table.
+type DatapointWatermark struct {
+	DatapointID int64     `boil:"datapoint_id" json:"datapoint_id" toml:"datapoint_id" yaml:"datapoint_id"`
+	ValueTime   time.Time `boil:"value_time" json:"value_time" toml:"value_time" yaml:"value_time"`
+
+	R *datapointWatermarkR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L datapointWatermarkL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var DatapointWatermarkColumns = struct {
+	DatapointID string
+	ValueTime   string
+}{
+	DatapointID: "datapoint_id",
+	ValueTime:   "value_time",
+}
+
+var DatapointWatermarkTableColumns = struct {
+	DatapointID string
+	ValueTime   string
+}{
+	DatapointID: "datapoint_watermark.datapoint_id",
+	ValueTime:   "datapoint_watermark.value_time",
+}
+
+// Generated where
+
+var DatapointWatermarkWhere = struct {
+	DatapointID whereHelperint64
+	ValueTime   whereHelpertime_Time
+}{
+	DatapointID: whereHelperint64{field: "\"abb_free_at_home\".\"datapoint_watermark\".\"datapoint_id\""},
+	ValueTime:   whereHelpertime_Time{field: "\"abb_free_at_home\".\"datapoint_watermark\".\"value_time\""},
+}
+
+// DatapointWatermarkRels is where relationship names are stored.
+var DatapointWatermarkRels = struct {
+	Datapoint string
+}{
+	Datapoint: "Datapoint",
+}
+
+// datapointWatermarkR is where relationships are stored.
+type datapointWatermarkR struct {
+	Datapoint *Datapoint `boil:"Datapoint" json:"Datapoint" toml:"Datapoint" yaml:"Datapoint"`
+}
+
+// NewStruct creates a new relationship struct
+func (*datapointWatermarkR) NewStruct() *datapointWatermarkR {
+	return &datapointWatermarkR{}
+}
+
+func (r *datapointWatermarkR) GetDatapoint() *Datapoint {
+	if r == nil {
+		return nil
+	}
+	return r.Datapoint
+}
+
+// datapointWatermarkL is where Load methods for each relationship are stored.
+type datapointWatermarkL struct{}
+
+var (
+	datapointWatermarkAllColumns            = []string{"datapoint_id", "value_time"}
+	datapointWatermarkColumnsWithoutDefault = []string{"datapoint_id", "value_time"}
+	datapointWatermarkColumnsWithDefault    = []string{}
+	datapointWatermarkPrimaryKeyColumns     = []string{"datapoint_id"}
+	datapointWatermarkGeneratedColumns      = []string{}
+)
+
+type (
+	// DatapointWatermarkSlice is an alias for a slice of pointers to DatapointWatermark.
+	// This should almost always be used instead of []DatapointWatermark.
+	DatapointWatermarkSlice []*DatapointWatermark
+	// DatapointWatermarkHook is the signature for custom DatapointWatermark hook methods
+	DatapointWatermarkHook func(context.Context, boil.ContextExecutor, *DatapointWatermark) error
+
+	datapointWatermarkQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	datapointWatermarkType                 = reflect.TypeOf(&DatapointWatermark{})
+	datapointWatermarkMapping              = queries.MakeStructMapping(datapointWatermarkType)
+	datapointWatermarkPrimaryKeyMapping, _ = queries.BindMapping(datapointWatermarkType, datapointWatermarkMapping, datapointWatermarkPrimaryKeyColumns)
+	datapointWatermarkInsertCacheMut       sync.RWMutex
+	datapointWatermarkInsertCache          = make(map[string]insertCache)
+	datapointWatermarkUpdateCacheMut       sync.RWMutex
+	datapointWatermarkUpdateCache          = make(map[string]updateCache)
+	datapointWatermarkUpsertCacheMut       sync.RWMutex
+	datapointWatermarkUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var datapointWatermarkAfterSelectMu sync.Mutex
+var datapointWatermarkAfterSelectHooks []DatapointWatermarkHook
+
+var datapointWatermarkBeforeInsertMu sync.Mutex
+var datapointWatermarkBeforeInsertHooks []DatapointWatermarkHook
+var datapointWatermarkAfterInsertMu sync.Mutex
+var datapointWatermarkAfterInsertHooks []DatapointWatermarkHook
+
+var datapointWatermarkBeforeUpdateMu sync.Mutex
+var datapointWatermarkBeforeUpdateHooks []DatapointWatermarkHook
+var datapointWatermarkAfterUpdateMu sync.Mutex
+var datapointWatermarkAfterUpdateHooks []DatapointWatermarkHook
+
+var datapointWatermarkBeforeDeleteMu sync.Mutex
+var datapointWatermarkBeforeDeleteHooks []DatapointWatermarkHook
+var datapointWatermarkAfterDeleteMu sync.Mutex
+var datapointWatermarkAfterDeleteHooks []DatapointWatermarkHook
+
+var datapointWatermarkBeforeUpsertMu sync.Mutex
+var datapointWatermarkBeforeUpsertHooks []DatapointWatermarkHook
+var datapointWatermarkAfterUpsertMu sync.Mutex
+var datapointWatermarkAfterUpsertHooks []DatapointWatermarkHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *DatapointWatermark) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range datapointWatermarkAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *DatapointWatermark) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range datapointWatermarkBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *DatapointWatermark) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range datapointWatermarkAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *DatapointWatermark) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range datapointWatermarkBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *DatapointWatermark) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range datapointWatermarkAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *DatapointWatermark) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range datapointWatermarkBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *DatapointWatermark) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range datapointWatermarkAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *DatapointWatermark) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range datapointWatermarkBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *DatapointWatermark) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range datapointWatermarkAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddDatapointWatermarkHook registers your hook function for all future operations.
+func AddDatapointWatermarkHook(hookPoint boil.HookPoint, datapointWatermarkHook DatapointWatermarkHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		datapointWatermarkAfterSelectMu.Lock()
+		datapointWatermarkAfterSelectHooks = append(datapointWatermarkAfterSelectHooks, datapointWatermarkHook)
+		datapointWatermarkAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		datapointWatermarkBeforeInsertMu.Lock()
+		datapointWatermarkBeforeInsertHooks = append(datapointWatermarkBeforeInsertHooks, datapointWatermarkHook)
+		datapointWatermarkBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		datapointWatermarkAfterInsertMu.Lock()
+		datapointWatermarkAfterInsertHooks = append(datapointWatermarkAfterInsertHooks, datapointWatermarkHook)
+		datapointWatermarkAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		datapointWatermarkBeforeUpdateMu.Lock()
+		datapointWatermarkBeforeUpdateHooks = append(datapointWatermarkBeforeUpdateHooks, datapointWatermarkHook)
+		datapointWatermarkBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		datapointWatermarkAfterUpdateMu.Lock()
+		datapointWatermarkAfterUpdateHooks = append(datapointWatermarkAfterUpdateHooks, datapointWatermarkHook)
+		datapointWatermarkAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		datapointWatermarkBeforeDeleteMu.Lock()
+		datapointWatermarkBeforeDeleteHooks = append(datapointWatermarkBeforeDeleteHooks, datapointWatermarkHook)
+		datapointWatermarkBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		datapointWatermarkAfterDeleteMu.Lock()
+		datapointWatermarkAfterDeleteHooks = append(datapointWatermarkAfterDeleteHooks, datapointWatermarkHook)
+		datapointWatermarkAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		datapointWatermarkBeforeUpsertMu.Lock()
+		datapointWatermarkBeforeUpsertHooks = append(datapointWatermarkBeforeUpsertHooks, datapointWatermarkHook)
+		datapointWatermarkBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		datapointWatermarkAfterUpsertMu.Lock()
+		datapointWatermarkAfterUpsertHooks = append(datapointWatermarkAfterUpsertHooks, datapointWatermarkHook)
+		datapointWatermarkAfterUpsertMu.Unlock()
+	}
+}
+
+// OneG returns a single datapointWatermark record from the query using the global executor.
+func (q datapointWatermarkQuery) OneG(ctx context.Context) (*DatapointWatermark, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single datapointWatermark record from the query.
+func (q datapointWatermarkQuery) One(ctx context.Context, exec boil.ContextExecutor) (*DatapointWatermark, error) {
+	o := &DatapointWatermark{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "appdb: failed to execute a one query for datapoint_watermark")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all DatapointWatermark records from the query using the global executor.
+func (q datapointWatermarkQuery) AllG(ctx context.Context) (DatapointWatermarkSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all DatapointWatermark records from the query.
+func (q datapointWatermarkQuery) All(ctx context.Context, exec boil.ContextExecutor) (DatapointWatermarkSlice, error) {
+	var o []*DatapointWatermark
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "appdb: failed to assign all query results to DatapointWatermark slice")
+	}
+
+	if len(datapointWatermarkAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all DatapointWatermark records in the query using the global executor
+func (q datapointWatermarkQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all DatapointWatermark records in the query.
+func (q datapointWatermarkQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to count datapoint_watermark rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q datapointWatermarkQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q datapointWatermarkQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "appdb: failed to check if datapoint_watermark exists")
+	}
+
+	return count > 0, nil
+}
+
+// Datapoint pointed to by the foreign key.
+func (o *DatapointWatermark) Datapoint(mods ...qm.QueryMod) datapointQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.DatapointID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Datapoints(queryMods...)
+}
+
+// LoadDatapoint allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (datapointWatermarkL) LoadDatapoint(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDatapointWatermark interface{}, mods queries.Applicator) error {
+	var slice []*DatapointWatermark
+	var object *DatapointWatermark
+
+	if singular {
+		var ok bool
+		object, ok = maybeDatapointWatermark.(*DatapointWatermark)
+		if !ok {
+			object = new(DatapointWatermark)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeDatapointWatermark)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeDatapointWatermark))
+			}
+		}
+	} else {
+		s, ok := maybeDatapointWatermark.(*[]*DatapointWatermark)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeDatapointWatermark)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeDatapointWatermark))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &datapointWatermarkR{}
+		}
+		args[object.DatapointID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &datapointWatermarkR{}
+			}
+
+			args[obj.DatapointID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`abb_free_at_home.datapoint`),
+		qm.WhereIn(`abb_free_at_home.datapoint.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Datapoint")
+	}
+
+	var resultSlice []*Datapoint
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Datapoint")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for datapoint")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for datapoint")
+	}
+
+	if len(datapointAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Datapoint = foreign
+		if foreign.R == nil {
+			foreign.R = &datapointR{}
+		}
+		foreign.R.DatapointWatermark = object
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.DatapointID == foreign.ID {
+				local.R.Datapoint = foreign
+				if foreign.R == nil {
+					foreign.R = &datapointR{}
+				}
+				foreign.R.DatapointWatermark = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetDatapointG of the datapointWatermark to the related item.
+// Sets o.R.Datapoint to related.
+// Adds o to related.R.DatapointWatermark.
+// Uses the global database handle.
+func (o *DatapointWatermark) SetDatapointG(ctx context.Context, insert bool, related *Datapoint) error {
+	return o.SetDatapoint(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetDatapoint of the datapointWatermark to the related item.
+// Sets o.R.Datapoint to related.
+// Adds o to related.R.DatapointWatermark.
+func (o *DatapointWatermark) SetDatapoint(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Datapoint) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"abb_free_at_home\".\"datapoint_watermark\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"datapoint_id"}),
+		strmangle.WhereClause("\"", "\"", 2, datapointWatermarkPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.DatapointID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.DatapointID = related.ID
+	if o.R == nil {
+		o.R = &datapointWatermarkR{
+			Datapoint: related,
+		}
+	} else {
+		o.R.Datapoint = related
+	}
+
+	if related.R == nil {
+		related.R = &datapointR{
+			DatapointWatermark: o,
+		}
+	} else {
+		related.R.DatapointWatermark = o
+	}
+
+	return nil
+}
+
+// DatapointWatermarks retrieves all the records using an executor.
+func DatapointWatermarks(mods ...qm.QueryMod) datapointWatermarkQuery {
+	mods = append(mods, qm.From("\"abb_free_at_home\".\"datapoint_watermark\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"abb_free_at_home\".\"datapoint_watermark\".*"})
+	}
+
+	return datapointWatermarkQuery{q}
+}
+
+// FindDatapointWatermarkG retrieves a single record by ID.
+func FindDatapointWatermarkG(ctx context.Context, datapointID int64, selectCols ...string) (*DatapointWatermark, error) {
+	return FindDatapointWatermark(ctx, boil.GetContextDB(), datapointID, selectCols...)
+}
+
+// FindDatapointWatermark retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindDatapointWatermark(ctx context.Context, exec boil.ContextExecutor, datapointID int64, selectCols ...string) (*DatapointWatermark, error) {
+	datapointWatermarkObj := &DatapointWatermark{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"abb_free_at_home\".\"datapoint_watermark\" where \"datapoint_id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, datapointID)
+
+	err := q.Bind(ctx, exec, datapointWatermarkObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "appdb: unable to select from datapoint_watermark")
+	}
+
+	if err = datapointWatermarkObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return datapointWatermarkObj, err
+	}
+
+	return datapointWatermarkObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *DatapointWatermark) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *DatapointWatermark) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("appdb: no datapoint_watermark provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(datapointWatermarkColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	datapointWatermarkInsertCacheMut.RLock()
+	cache, cached := datapointWatermarkInsertCache[key]
+	datapointWatermarkInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			datapointWatermarkAllColumns,
+			datapointWatermarkColumnsWithDefault,
+			datapointWatermarkColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(datapointWatermarkType, datapointWatermarkMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(datapointWatermarkType, datapointWatermarkMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"abb_free_at_home\".\"datapoint_watermark\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"abb_free_at_home\".\"datapoint_watermark\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to insert into datapoint_watermark")
+	}
+
+	if !cached {
+		datapointWatermarkInsertCacheMut.Lock()
+		datapointWatermarkInsertCache[key] = cache
+		datapointWatermarkInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single DatapointWatermark record using the global executor.
+// See Update for more documentation.
+func (o *DatapointWatermark) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the DatapointWatermark.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *DatapointWatermark) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	datapointWatermarkUpdateCacheMut.RLock()
+	cache, cached := datapointWatermarkUpdateCache[key]
+	datapointWatermarkUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			datapointWatermarkAllColumns,
+			datapointWatermarkPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("appdb: unable to update datapoint_watermark, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"abb_free_at_home\".\"datapoint_watermark\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, datapointWatermarkPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(datapointWatermarkType, datapointWatermarkMapping, append(wl, datapointWatermarkPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update datapoint_watermark row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by update for datapoint_watermark")
+	}
+
+	if !cached {
+		datapointWatermarkUpdateCacheMut.Lock()
+		datapointWatermarkUpdateCache[key] = cache
+		datapointWatermarkUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q datapointWatermarkQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q datapointWatermarkQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update all for datapoint_watermark")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to retrieve rows affected for datapoint_watermark")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o DatapointWatermarkSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o DatapointWatermarkSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("appdb: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), datapointWatermarkPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"abb_free_at_home\".\"datapoint_watermark\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, datapointWatermarkPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update all in datapointWatermark slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to retrieve rows affected all in update all datapointWatermark")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *DatapointWatermark) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *DatapointWatermark) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("appdb: no datapoint_watermark provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(datapointWatermarkColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	datapointWatermarkUpsertCacheMut.RLock()
+	cache, cached := datapointWatermarkUpsertCache[key]
+	datapointWatermarkUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			datapointWatermarkAllColumns,
+			datapointWatermarkColumnsWithDefault,
+			datapointWatermarkColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			datapointWatermarkAllColumns,
+			datapointWatermarkPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("appdb: unable to upsert datapoint_watermark, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(datapointWatermarkAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(datapointWatermarkPrimaryKeyColumns) == 0 {
+				return errors.New("appdb: unable to upsert datapoint_watermark, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(datapointWatermarkPrimaryKeyColumns))
+			copy(conflict, datapointWatermarkPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"abb_free_at_home\".\"datapoint_watermark\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(datapointWatermarkType, datapointWatermarkMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(datapointWatermarkType, datapointWatermarkMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to upsert datapoint_watermark")
+	}
+
+	if !cached {
+		datapointWatermarkUpsertCacheMut.Lock()
+		datapointWatermarkUpsertCache[key] = cache
+		datapointWatermarkUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single DatapointWatermark record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *DatapointWatermark) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single DatapointWatermark record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *DatapointWatermark) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("appdb: no DatapointWatermark provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), datapointWatermarkPrimaryKeyMapping)
+	sql := "DELETE FROM \"abb_free_at_home\".\"datapoint_watermark\" WHERE \"datapoint_id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete from datapoint_watermark")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by delete for datapoint_watermark")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q datapointWatermarkQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q datapointWatermarkQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("appdb: no datapointWatermarkQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete all from datapoint_watermark")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by deleteall for datapoint_watermark")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o DatapointWatermarkSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o DatapointWatermarkSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(datapointWatermarkBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), datapointWatermarkPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"abb_free_at_home\".\"datapoint_watermark\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, datapointWatermarkPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete all from datapointWatermark slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by deleteall for datapoint_watermark")
+	}
+
+	if len(datapointWatermarkAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *DatapointWatermark) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("appdb: no DatapointWatermark provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *DatapointWatermark) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindDatapointWatermark(ctx, exec, o.DatapointID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *DatapointWatermarkSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("appdb: empty DatapointWatermarkSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *DatapointWatermarkSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := DatapointWatermarkSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), datapointWatermarkPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"abb_free_at_home\".\"datapoint_watermark\".* FROM \"abb_free_at_home\".\"datapoint_watermark\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, datapointWatermarkPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to reload all in DatapointWatermarkSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// DatapointWatermarkExistsG checks if the DatapointWatermark row exists.
+func DatapointWatermarkExistsG(ctx context.Context, datapointID int64) (bool, error) {
+	return DatapointWatermarkExists(ctx, boil.GetContextDB(), datapointID)
+}
+
+// DatapointWatermarkExists checks if the DatapointWatermark row exists.
+func DatapointWatermarkExists(ctx context.Context, exec boil.ContextExecutor, datapointID int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"abb_free_at_home\".\"datapoint_watermark\" where \"datapoint_id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, datapointID)
+	}
+	row := exec.QueryRowContext(ctx, sql, datapointID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "appdb: unable to check if datapoint_watermark exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the DatapointWatermark row exists.
+func (o *DatapointWatermark) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return DatapointWatermarkExists(ctx, exec, o.DatapointID)
+}
@@ -0,0 +1,1229 @@
+// Code generated by SQLBoiler 4.16.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package appdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// DeviceAlert is an object representing the database table.
+type DeviceAlert struct {
+	ConfigurationID int64     `boil:"configuration_id" json:"configuration_id" toml:"configuration_id" yaml:"configuration_id"`
+	DeviceGai       string    `boil:"device_gai" json:"device_gai" toml:"device_gai" yaml:"device_gai"`
+	Kind            string    `boil:"kind" json:"kind" toml:"kind" yaml:"kind"`
+	RaisedAt        time.Time `boil:"raised_at" json:"raised_at" toml:"raised_at" yaml:"raised_at"`
+
+	R *deviceAlertR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L deviceAlertL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var DeviceAlertColumns = struct {
+	ConfigurationID string
+	DeviceGai       string
+	Kind            string
+	RaisedAt        string
+}{
+	ConfigurationID: "configuration_id",
+	DeviceGai:       "device_gai",
+	Kind:            "kind",
+	RaisedAt:        "raised_at",
+}
+
+var DeviceAlertTableColumns = struct {
+	ConfigurationID string
+	DeviceGai       string
+	Kind            string
+	RaisedAt        string
+}{
+	ConfigurationID: "device_alert.configuration_id",
+	DeviceGai:       "device_alert.device_gai",
+	Kind:            "device_alert.kind",
+	RaisedAt:        "device_alert.raised_at",
+}
+
+// Generated where
+
+var DeviceAlertWhere = struct {
+	ConfigurationID whereHelperint64
+	DeviceGai       whereHelperstring
+	Kind            whereHelperstring
+	RaisedAt        whereHelpertime_Time
+}{
+	ConfigurationID: whereHelperint64{field: "\"abb_free_at_home\".\"device_alert\".\"configuration_id\""},
+	DeviceGai:       whereHelperstring{field: "\"abb_free_at_home\".\"device_alert\".\"device_gai\""},
+	Kind:            whereHelperstring{field: "\"abb_free_at_home\".\"device_alert\".\"kind\""},
+	RaisedAt:        whereHelpertime_Time{field: "\"abb_free_at_home\".\"device_alert\".\"raised_at\""},
+}
+
+// DeviceAlertRels is where relationship names are stored.
+var DeviceAlertRels = struct {
+	Configuration string
+}{
+	Configuration: "Configuration",
+}
+
+// deviceAlertR is where relationships are stored.
+type deviceAlertR struct {
+	Configuration *Configuration `boil:"Configuration" json:"Configuration" toml:"Configuration" yaml:"Configuration"`
+}
+
+// NewStruct creates a new relationship struct
+func (*deviceAlertR) NewStruct() *deviceAlertR {
+	return &deviceAlertR{}
+}
+
+func (r *deviceAlertR) GetConfiguration() *Configuration {
+	if r == nil {
+		return nil
+	}
+	return r.Configuration
+}
+
+// deviceAlertL is where Load methods for each relationship are stored.
+type deviceAlertL struct{}
+
+var (
+	deviceAlertAllColumns            = []string{"configuration_id", "device_gai", "kind", "raised_at"}
+	deviceAlertColumnsWithoutDefault = []string{"configuration_id", "device_gai", "kind"}
+	deviceAlertColumnsWithDefault    = []string{"raised_at"}
+	deviceAlertPrimaryKeyColumns     = []string{"configuration_id", "device_gai", "kind"}
+	deviceAlertGeneratedColumns      = []string{}
+)
+
+type (
+	// DeviceAlertSlice is an alias for a slice of pointers to DeviceAlert.
+	// This should almost always be used instead of []DeviceAlert.
+	DeviceAlertSlice []*DeviceAlert
+	// DeviceAlertHook is the signature for custom DeviceAlert hook methods
+	DeviceAlertHook func(context.Context, boil.ContextExecutor, *DeviceAlert) error
+
+	deviceAlertQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	deviceAlertType                 = reflect.TypeOf(&DeviceAlert{})
+	deviceAlertMapping              = queries.MakeStructMapping(deviceAlertType)
+	deviceAlertPrimaryKeyMapping, _ = queries.BindMapping(deviceAlertType, deviceAlertMapping, deviceAlertPrimaryKeyColumns)
+	deviceAlertInsertCacheMut       sync.RWMutex
+	deviceAlertInsertCache          = make(map[string]insertCache)
+	deviceAlertUpdateCacheMut       sync.RWMutex
+	deviceAlertUpdateCache          = make(map[string]updateCache)
+	deviceAlertUpsertCacheMut       sync.RWMutex
+	deviceAlertUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var deviceAlertAfterSelectMu sync.Mutex
+var deviceAlertAfterSelectHooks []DeviceAlertHook
+
+var deviceAlertBeforeInsertMu sync.Mutex
+var deviceAlertBeforeInsertHooks []DeviceAlertHook
+var deviceAlertAfterInsertMu sync.Mutex
+var deviceAlertAfterInsertHooks []DeviceAlertHook
+
+var deviceAlertBeforeUpdateMu sync.Mutex
+var deviceAlertBeforeUpdateHooks []DeviceAlertHook
+var deviceAlertAfterUpdateMu sync.Mutex
+var deviceAlertAfterUpdateHooks []DeviceAlertHook
+
+var deviceAlertBeforeDeleteMu sync.Mutex
+var deviceAlertBeforeDeleteHooks []DeviceAlertHook
+var deviceAlertAfterDeleteMu sync.Mutex
+var deviceAlertAfterDeleteHooks []DeviceAlertHook
+
+var deviceAlertBeforeUpsertMu sync.Mutex
+var deviceAlertBeforeUpsertHooks []DeviceAlertHook
+var deviceAlertAfterUpsertMu sync.Mutex
+var deviceAlertAfterUpsertHooks []DeviceAlertHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *DeviceAlert) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range deviceAlertAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *DeviceAlert) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range deviceAlertBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *DeviceAlert) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range deviceAlertAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *DeviceAlert) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range deviceAlertBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *DeviceAlert) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range deviceAlertAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *DeviceAlert) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range deviceAlertBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *DeviceAlert) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range deviceAlertAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *DeviceAlert) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range deviceAlertBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *DeviceAlert) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range deviceAlertAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddDeviceAlertHook registers your hook function for all future operations.
+func AddDeviceAlertHook(hookPoint boil.HookPoint, deviceAlertHook DeviceAlertHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		deviceAlertAfterSelectMu.Lock()
+		deviceAlertAfterSelectHooks = append(deviceAlertAfterSelectHooks, deviceAlertHook)
+		deviceAlertAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		deviceAlertBeforeInsertMu.Lock()
+		deviceAlertBeforeInsertHooks = append(deviceAlertBeforeInsertHooks, deviceAlertHook)
+		deviceAlertBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		deviceAlertAfterInsertMu.Lock()
+		deviceAlertAfterInsertHooks = append(deviceAlertAfterInsertHooks, deviceAlertHook)
+		deviceAlertAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		deviceAlertBeforeUpdateMu.Lock()
+		deviceAlertBeforeUpdateHooks = append(deviceAlertBeforeUpdateHooks, deviceAlertHook)
+		deviceAlertBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		deviceAlertAfterUpdateMu.Lock()
+		deviceAlertAfterUpdateHooks = append(deviceAlertAfterUpdateHooks, deviceAlertHook)
+		deviceAlertAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		deviceAlertBeforeDeleteMu.Lock()
+		deviceAlertBeforeDeleteHooks = append(deviceAlertBeforeDeleteHooks, deviceAlertHook)
+		deviceAlertBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		deviceAlertAfterDeleteMu.Lock()
+		deviceAlertAfterDeleteHooks = append(deviceAlertAfterDeleteHooks, deviceAlertHook)
+		deviceAlertAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		deviceAlertBeforeUpsertMu.Lock()
+		deviceAlertBeforeUpsertHooks = append(deviceAlertBeforeUpsertHooks, deviceAlertHook)
+		deviceAlertBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		deviceAlertAfterUpsertMu.Lock()
+		deviceAlertAfterUpsertHooks = append(deviceAlertAfterUpsertHooks, deviceAlertHook)
+		deviceAlertAfterUpsertMu.Unlock()
+	}
+}
+
+// OneG returns a single deviceAlert record from the query using the global executor.
+func (q deviceAlertQuery) OneG(ctx context.Context) (*DeviceAlert, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single deviceAlert record from the query.
+func (q deviceAlertQuery) One(ctx context.Context, exec boil.ContextExecutor) (*DeviceAlert, error) {
+	o := &DeviceAlert{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "appdb: failed to execute a one query for device_alert")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all DeviceAlert records from the query using the global executor.
+func (q deviceAlertQuery) AllG(ctx context.Context) (DeviceAlertSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all DeviceAlert records from the query.
+func (q deviceAlertQuery) All(ctx context.Context, exec boil.ContextExecutor) (DeviceAlertSlice, error) {
+	var o []*DeviceAlert
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "appdb: failed to assign all query results to DeviceAlert slice")
+	}
+
+	if len(deviceAlertAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all DeviceAlert records in the query using the global executor
+func (q deviceAlertQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all DeviceAlert records in the query.
+func (q deviceAlertQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to count device_alert rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q deviceAlertQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q deviceAlertQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "appdb: failed to check if device_alert exists")
+	}
+
+	return count > 0, nil
+}
+
+// Configuration pointed to by the foreign key.
+func (o *DeviceAlert) Configuration(mods ...qm.QueryMod) configurationQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ConfigurationID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Configurations(queryMods...)
+}
+
+// LoadConfiguration allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (deviceAlertL) LoadConfiguration(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDeviceAlert interface{}, mods queries.Applicator) error {
+	var slice []*DeviceAlert
+	var object *DeviceAlert
+
+	if singular {
+		var ok bool
+		object, ok = maybeDeviceAlert.(*DeviceAlert)
+		if !ok {
+			object = new(DeviceAlert)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeDeviceAlert)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeDeviceAlert))
+			}
+		}
+	} else {
+		s, ok := maybeDeviceAlert.(*[]*DeviceAlert)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeDeviceAlert)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeDeviceAlert))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &deviceAlertR{}
+		}
+		args[object.ConfigurationID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &deviceAlertR{}
+			}
+
+			args[obj.ConfigurationID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`abb_free_at_home.configuration`),
+		qm.WhereIn(`abb_free_at_home.configuration.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Configuration")
+	}
+
+	var resultSlice []*Configuration
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Configuration")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for configuration")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for configuration")
+	}
+
+	if len(configurationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Configuration = foreign
+		if foreign.R == nil {
+			foreign.R = &configurationR{}
+		}
+		foreign.R.DeviceAlerts = append(foreign.R.DeviceAlerts, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ConfigurationID == foreign.ID {
+				local.R.Configuration = foreign
+				if foreign.R == nil {
+					foreign.R = &configurationR{}
+				}
+				foreign.R.DeviceAlerts = append(foreign.R.DeviceAlerts, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetConfigurationG of the deviceAlert to the related item.
+// Sets o.R.Configuration to related.
+// Adds o to related.R.DeviceAlerts.
+// Uses the global database handle.
+func (o *DeviceAlert) SetConfigurationG(ctx context.Context, insert bool, related *Configuration) error {
+	return o.SetConfiguration(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetConfiguration of the deviceAlert to the related item.
+// Sets o.R.Configuration to related.
+// Adds o to related.R.DeviceAlerts.
+func (o *DeviceAlert) SetConfiguration(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Configuration) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"abb_free_at_home\".\"device_alert\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"configuration_id"}),
+		strmangle.WhereClause("\"", "\"", 2, deviceAlertPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ConfigurationID, o.DeviceGai, o.Kind}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ConfigurationID = related.ID
+	if o.R == nil {
+		o.R = &deviceAlertR{
+			Configuration: related,
+		}
+	} else {
+		o.R.Configuration = related
+	}
+
+	if related.R == nil {
+		related.R = &configurationR{
+			DeviceAlerts: DeviceAlertSlice{o},
+		}
+	} else {
+		related.R.DeviceAlerts = append(related.R.DeviceAlerts, o)
+	}
+
+	return nil
+}
+
+// DeviceAlerts retrieves all the records using an executor.
+func DeviceAlerts(mods ...qm.QueryMod) deviceAlertQuery {
+	mods = append(mods, qm.From("\"abb_free_at_home\".\"device_alert\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"abb_free_at_home\".\"device_alert\".*"})
+	}
+
+	return deviceAlertQuery{q}
+}
+
+// FindDeviceAlertG retrieves a single record by ID.
+func FindDeviceAlertG(ctx context.Context, configurationID int64, deviceGai string, kind string, selectCols ...string) (*DeviceAlert, error) {
+	return FindDeviceAlert(ctx, boil.GetContextDB(), configurationID, deviceGai, kind, selectCols...)
+}
+
+// FindDeviceAlert retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindDeviceAlert(ctx context.Context, exec boil.ContextExecutor, configurationID int64, deviceGai string, kind string, selectCols ...string) (*DeviceAlert, error) {
+	deviceAlertObj := &DeviceAlert{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"abb_free_at_home\".\"device_alert\" where \"configuration_id\"=$1 AND \"device_gai\"=$2 AND \"kind\"=$3", sel,
+	)
+
+	q := queries.Raw(query, configurationID, deviceGai, kind)
+
+	err := q.Bind(ctx, exec, deviceAlertObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "appdb: unable to select from device_alert")
+	}
+
+	if err = deviceAlertObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return deviceAlertObj, err
+	}
+
+	return deviceAlertObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *DeviceAlert) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *DeviceAlert) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("appdb: no device_alert provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(deviceAlertColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	deviceAlertInsertCacheMut.RLock()
+	cache, cached := deviceAlertInsertCache[key]
+	deviceAlertInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			deviceAlertAllColumns,
+			deviceAlertColumnsWithDefault,
+			deviceAlertColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(deviceAlertType, deviceAlertMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(deviceAlertType, deviceAlertMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"abb_free_at_home\".\"device_alert\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"abb_free_at_home\".\"device_alert\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to insert into device_alert")
+	}
+
+	if !cached {
+		deviceAlertInsertCacheMut.Lock()
+		deviceAlertInsertCache[key] = cache
+		deviceAlertInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single DeviceAlert record using the global executor.
+// See Update for more documentation.
+func (o *DeviceAlert) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the DeviceAlert.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *DeviceAlert) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	deviceAlertUpdateCacheMut.RLock()
+	cache, cached := deviceAlertUpdateCache[key]
+	deviceAlertUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			deviceAlertAllColumns,
+			deviceAlertPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("appdb: unable to update device_alert, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"abb_free_at_home\".\"device_alert\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, deviceAlertPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(deviceAlertType, deviceAlertMapping, append(wl, deviceAlertPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update device_alert row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by update for device_alert")
+	}
+
+	if !cached {
+		deviceAlertUpdateCacheMut.Lock()
+		deviceAlertUpdateCache[key] = cache
+		deviceAlertUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q deviceAlertQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q deviceAlertQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update all for device_alert")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to retrieve rows affected for device_alert")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o DeviceAlertSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o DeviceAlertSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("appdb: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), deviceAlertPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"abb_free_at_home\".\"device_alert\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, deviceAlertPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update all in deviceAlert slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to retrieve rows affected all in update all deviceAlert")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *DeviceAlert) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *DeviceAlert) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("appdb: no device_alert provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(deviceAlertColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	deviceAlertUpsertCacheMut.RLock()
+	cache, cached := deviceAlertUpsertCache[key]
+	deviceAlertUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			deviceAlertAllColumns,
+			deviceAlertColumnsWithDefault,
+			deviceAlertColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			deviceAlertAllColumns,
+			deviceAlertPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("appdb: unable to upsert device_alert, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(deviceAlertAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(deviceAlertPrimaryKeyColumns) == 0 {
+				return errors.New("appdb: unable to upsert device_alert, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(deviceAlertPrimaryKeyColumns))
+			copy(conflict, deviceAlertPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"abb_free_at_home\".\"device_alert\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(deviceAlertType, deviceAlertMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(deviceAlertType, deviceAlertMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to upsert device_alert")
+	}
+
+	if !cached {
+		deviceAlertUpsertCacheMut.Lock()
+		deviceAlertUpsertCache[key] = cache
+		deviceAlertUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single DeviceAlert record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *DeviceAlert) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single DeviceAlert record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *DeviceAlert) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("appdb: no DeviceAlert provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), deviceAlertPrimaryKeyMapping)
+	sql := "DELETE FROM \"abb_free_at_home\".\"device_alert\" WHERE \"configuration_id\"=$1 AND \"device_gai\"=$2 AND \"kind\"=$3"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete from device_alert")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by delete for device_alert")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q deviceAlertQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q deviceAlertQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("appdb: no deviceAlertQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete all from device_alert")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by deleteall for device_alert")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o DeviceAlertSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o DeviceAlertSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(deviceAlertBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), deviceAlertPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"abb_free_at_home\".\"device_alert\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, deviceAlertPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete all from deviceAlert slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by deleteall for device_alert")
+	}
+
+	if len(deviceAlertAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *DeviceAlert) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("appdb: no DeviceAlert provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *DeviceAlert) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindDeviceAlert(ctx, exec, o.ConfigurationID, o.DeviceGai, o.Kind)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *DeviceAlertSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("appdb: empty DeviceAlertSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *DeviceAlertSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := DeviceAlertSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), deviceAlertPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"abb_free_at_home\".\"device_alert\".* FROM \"abb_free_at_home\".\"device_alert\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, deviceAlertPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to reload all in DeviceAlertSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// DeviceAlertExistsG checks if the DeviceAlert row exists.
+func DeviceAlertExistsG(ctx context.Context, configurationID int64, deviceGai string, kind string) (bool, error) {
+	return DeviceAlertExists(ctx, boil.GetContextDB(), configurationID, deviceGai, kind)
+}
+
+// DeviceAlertExists checks if the DeviceAlert row exists.
+func DeviceAlertExists(ctx context.Context, exec boil.ContextExecutor, configurationID int64, deviceGai string, kind string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"abb_free_at_home\".\"device_alert\" where \"configuration_id\"=$1 AND \"device_gai\"=$2 AND \"kind\"=$3 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, configurationID, deviceGai, kind)
+	}
+	row := exec.QueryRowContext(ctx, sql, configurationID, deviceGai, kind)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "appdb: unable to check if device_alert exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the DeviceAlert row exists.
+func (o *DeviceAlert) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return DeviceAlertExists(ctx, exec, o.ConfigurationID, o.DeviceGai, o.Kind)
+}
@@ -0,0 +1,1322 @@
+// Code generated by SQLBoiler 4.16.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package appdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"github.com/volatiletech/strmangle"
+)
+
+// SyncJob is an object representing the database table.
+type SyncJob struct {
+	ID              int64      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ConfigurationID int64      `boil:"configuration_id" json:"configuration_id" toml:"configuration_id" yaml:"configuration_id"`
+	Mode            string     `boil:"mode" json:"mode" toml:"mode" yaml:"mode"`
+	Status          string     `boil:"status" json:"status" toml:"status" yaml:"status"`
+	Progress        int32      `boil:"progress" json:"progress" toml:"progress" yaml:"progress"`
+	Step            string     `boil:"step" json:"step" toml:"step" yaml:"step"`
+	AssetsCreated   int32      `boil:"assets_created" json:"assets_created" toml:"assets_created" yaml:"assets_created"`
+	AssetsUpdated   int32      `boil:"assets_updated" json:"assets_updated" toml:"assets_updated" yaml:"assets_updated"`
+	AssetsOrphaned  int32      `boil:"assets_orphaned" json:"assets_orphaned" toml:"assets_orphaned" yaml:"assets_orphaned"`
+	AssetsRetired   int32      `boil:"assets_retired" json:"assets_retired" toml:"assets_retired" yaml:"assets_retired"`
+	Changes         types.JSON `boil:"changes" json:"changes" toml:"changes" yaml:"changes"`
+	Errors          types.JSON `boil:"errors" json:"errors" toml:"errors" yaml:"errors"`
+	CreatedAt       time.Time  `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	StartedAt       null.Time  `boil:"started_at" json:"started_at,omitempty" toml:"started_at" yaml:"started_at,omitempty"`
+	FinishedAt      null.Time  `boil:"finished_at" json:"finished_at,omitempty" toml:"finished_at" yaml:"finished_at,omitempty"`
+
+	R *syncJobR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L syncJobL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var SyncJobColumns = struct {
+	ID              string
+	ConfigurationID string
+	Mode            string
+	Status          string
+	Progress        string
+	Step            string
+	AssetsCreated   string
+	AssetsUpdated   string
+	AssetsOrphaned  string
+	AssetsRetired   string
+	Changes         string
+	Errors          string
+	CreatedAt       string
+	StartedAt       string
+	FinishedAt      string
+}{
+	ID:              "id",
+	ConfigurationID: "configuration_id",
+	Mode:            "mode",
+	Status:          "status",
+	Progress:        "progress",
+	Step:            "step",
+	AssetsCreated:   "assets_created",
+	AssetsUpdated:   "assets_updated",
+	AssetsOrphaned:  "assets_orphaned",
+	AssetsRetired:   "assets_retired",
+	Changes:         "changes",
+	Errors:          "errors",
+	CreatedAt:       "created_at",
+	StartedAt:       "started_at",
+	FinishedAt:      "finished_at",
+}
+
+var SyncJobTableColumns = struct {
+	ID              string
+	ConfigurationID string
+	Mode            string
+	Status          string
+	Progress        string
+	Step            string
+	AssetsCreated   string
+	AssetsUpdated   string
+	AssetsOrphaned  string
+	AssetsRetired   string
+	Changes         string
+	Errors          string
+	CreatedAt       string
+	StartedAt       string
+	FinishedAt      string
+}{
+	ID:              "sync_job.id",
+	ConfigurationID: "sync_job.configuration_id",
+	Mode:            "sync_job.mode",
+	Status:          "sync_job.status",
+	Progress:        "sync_job.progress",
+	Step:            "sync_job.step",
+	AssetsCreated:   "sync_job.assets_created",
+	AssetsUpdated:   "sync_job.assets_updated",
+	AssetsOrphaned:  "sync_job.assets_orphaned",
+	AssetsRetired:   "sync_job.assets_retired",
+	Changes:         "sync_job.changes",
+	Errors:          "sync_job.errors",
+	CreatedAt:       "sync_job.created_at",
+	StartedAt:       "sync_job.started_at",
+	FinishedAt:      "sync_job.finished_at",
+}
+
+// Generated where
+
+var SyncJobWhere = struct {
+	ID              whereHelperint64
+	ConfigurationID whereHelperint64
+	Mode            whereHelperstring
+	Status          whereHelperstring
+	Progress        whereHelperint32
+	Step            whereHelperstring
+	AssetsCreated   whereHelperint32
+	AssetsUpdated   whereHelperint32
+	AssetsOrphaned  whereHelperint32
+	AssetsRetired   whereHelperint32
+	Changes         whereHelpertypes_JSON
+	Errors          whereHelpertypes_JSON
+	CreatedAt       whereHelpertime_Time
+	StartedAt       whereHelpernull_Time
+	FinishedAt      whereHelpernull_Time
+}{
+	ID:              whereHelperint64{field: "\"abb_free_at_home\".\"sync_job\".\"id\""},
+	ConfigurationID: whereHelperint64{field: "\"abb_free_at_home\".\"sync_job\".\"configuration_id\""},
+	Mode:            whereHelperstring{field: "\"abb_free_at_home\".\"sync_job\".\"mode\""},
+	Status:          whereHelperstring{field: "\"abb_free_at_home\".\"sync_job\".\"status\""},
+	Progress:        whereHelperint32{field: "\"abb_free_at_home\".\"sync_job\".\"progress\""},
+	Step:            whereHelperstring{field: "\"abb_free_at_home\".\"sync_job\".\"step\""},
+	AssetsCreated:   whereHelperint32{field: "\"abb_free_at_home\".\"sync_job\".\"assets_created\""},
+	AssetsUpdated:   whereHelperint32{field: "\"abb_free_at_home\".\"sync_job\".\"assets_updated\""},
+	AssetsOrphaned:  whereHelperint32{field: "\"abb_free_at_home\".\"sync_job\".\"assets_orphaned\""},
+	AssetsRetired:   whereHelperint32{field: "\"abb_free_at_home\".\"sync_job\".\"assets_retired\""},
+	Changes:         whereHelpertypes_JSON{field: "\"abb_free_at_home\".\"sync_job\".\"changes\""},
+	Errors:          whereHelpertypes_JSON{field: "\"abb_free_at_home\".\"sync_job\".\"errors\""},
+	CreatedAt:       whereHelpertime_Time{field: "\"abb_free_at_home\".\"sync_job\".\"created_at\""},
+	StartedAt:       whereHelpernull_Time{field: "\"abb_free_at_home\".\"sync_job\".\"started_at\""},
+	FinishedAt:      whereHelpernull_Time{field: "\"abb_free_at_home\".\"sync_job\".\"finished_at\""},
+}
+
+// SyncJobRels is where relationship names are stored.
+var SyncJobRels = struct {
+	Configuration string
+}{
+	Configuration: "Configuration",
+}
+
+// syncJobR is where relationships are stored.
+type syncJobR struct {
+	Configuration *Configuration `boil:"Configuration" json:"Configuration" toml:"Configuration" yaml:"Configuration"`
+}
+
+// NewStruct creates a new relationship struct
+func (*syncJobR) NewStruct() *syncJobR {
+	return &syncJobR{}
+}
+
+func (r *syncJobR) GetConfiguration() *Configuration {
+	if r == nil {
+		return nil
+	}
+	return r.Configuration
+}
+
+// syncJobL is where Load methods for each relationship are stored.
+type syncJobL struct{}
+
+var (
+	syncJobAllColumns            = []string{"id", "configuration_id", "mode", "status", "progress", "step", "assets_created", "assets_updated", "assets_orphaned", "assets_retired", "changes", "errors", "created_at", "started_at", "finished_at"}
+	syncJobColumnsWithoutDefault = []string{"configuration_id", "mode"}
+	syncJobColumnsWithDefault    = []string{"id", "status", "progress", "step", "assets_created", "assets_updated", "assets_orphaned", "assets_retired", "changes", "errors", "created_at", "started_at", "finished_at"}
+	syncJobPrimaryKeyColumns     = []string{"id"}
+	syncJobGeneratedColumns      = []string{}
+)
+
+type (
+	// SyncJobSlice is an alias for a slice of pointers to SyncJob.
+	// This should almost always be used instead of []SyncJob.
+	SyncJobSlice []*SyncJob
+	// SyncJobHook is the signature for custom SyncJob hook methods
+	SyncJobHook func(context.Context, boil.ContextExecutor, *SyncJob) error
+
+	syncJobQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	syncJobType                 = reflect.TypeOf(&SyncJob{})
+	syncJobMapping              = queries.MakeStructMapping(syncJobType)
+	syncJobPrimaryKeyMapping, _ = queries.BindMapping(syncJobType, syncJobMapping, syncJobPrimaryKeyColumns)
+	syncJobInsertCacheMut       sync.RWMutex
+	syncJobInsertCache          = make(map[string]insertCache)
+	syncJobUpdateCacheMut       sync.RWMutex
+	syncJobUpdateCache          = make(map[string]updateCache)
+	syncJobUpsertCacheMut       sync.RWMutex
+	syncJobUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var syncJobAfterSelectMu sync.Mutex
+var syncJobAfterSelectHooks []SyncJobHook
+
+var syncJobBeforeInsertMu sync.Mutex
+var syncJobBeforeInsertHooks []SyncJobHook
+var syncJobAfterInsertMu sync.Mutex
+var syncJobAfterInsertHooks []SyncJobHook
+
+var syncJobBeforeUpdateMu sync.Mutex
+var syncJobBeforeUpdateHooks []SyncJobHook
+var syncJobAfterUpdateMu sync.Mutex
+var syncJobAfterUpdateHooks []SyncJobHook
+
+var syncJobBeforeDeleteMu sync.Mutex
+var syncJobBeforeDeleteHooks []SyncJobHook
+var syncJobAfterDeleteMu sync.Mutex
+var syncJobAfterDeleteHooks []SyncJobHook
+
+var syncJobBeforeUpsertMu sync.Mutex
+var syncJobBeforeUpsertHooks []SyncJobHook
+var syncJobAfterUpsertMu sync.Mutex
+var syncJobAfterUpsertHooks []SyncJobHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *SyncJob) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range syncJobAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *SyncJob) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range syncJobBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *SyncJob) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range syncJobAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *SyncJob) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range syncJobBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *SyncJob) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range syncJobAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *SyncJob) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range syncJobBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *SyncJob) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range syncJobAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *SyncJob) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range syncJobBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *SyncJob) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range syncJobAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddSyncJobHook registers your hook function for all future operations.
+func AddSyncJobHook(hookPoint boil.HookPoint, syncJobHook SyncJobHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		syncJobAfterSelectMu.Lock()
+		syncJobAfterSelectHooks = append(syncJobAfterSelectHooks, syncJobHook)
+		syncJobAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		syncJobBeforeInsertMu.Lock()
+		syncJobBeforeInsertHooks = append(syncJobBeforeInsertHooks, syncJobHook)
+		syncJobBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		syncJobAfterInsertMu.Lock()
+		syncJobAfterInsertHooks = append(syncJobAfterInsertHooks, syncJobHook)
+		syncJobAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		syncJobBeforeUpdateMu.Lock()
+		syncJobBeforeUpdateHooks = append(syncJobBeforeUpdateHooks, syncJobHook)
+		syncJobBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		syncJobAfterUpdateMu.Lock()
+		syncJobAfterUpdateHooks = append(syncJobAfterUpdateHooks, syncJobHook)
+		syncJobAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		syncJobBeforeDeleteMu.Lock()
+		syncJobBeforeDeleteHooks = append(syncJobBeforeDeleteHooks, syncJobHook)
+		syncJobBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		syncJobAfterDeleteMu.Lock()
+		syncJobAfterDeleteHooks = append(syncJobAfterDeleteHooks, syncJobHook)
+		syncJobAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		syncJobBeforeUpsertMu.Lock()
+		syncJobBeforeUpsertHooks = append(syncJobBeforeUpsertHooks, syncJobHook)
+		syncJobBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		syncJobAfterUpsertMu.Lock()
+		syncJobAfterUpsertHooks = append(syncJobAfterUpsertHooks, syncJobHook)
+		syncJobAfterUpsertMu.Unlock()
+	}
+}
+
+// OneG returns a single syncJob record from the query using the global executor.
+func (q syncJobQuery) OneG(ctx context.Context) (*SyncJob, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single syncJob record from the query.
+func (q syncJobQuery) One(ctx context.Context, exec boil.ContextExecutor) (*SyncJob, error) {
+	o := &SyncJob{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "appdb: failed to execute a one query for sync_job")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all SyncJob records from the query using the global executor.
+func (q syncJobQuery) AllG(ctx context.Context) (SyncJobSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all SyncJob records from the query.
+func (q syncJobQuery) All(ctx context.Context, exec boil.ContextExecutor) (SyncJobSlice, error) {
+	var o []*SyncJob
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "appdb: failed to assign all query results to SyncJob slice")
+	}
+
+	if len(syncJobAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all SyncJob records in the query using the global executor
+func (q syncJobQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all SyncJob records in the query.
+func (q syncJobQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to count sync_job rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q syncJobQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q syncJobQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "appdb: failed to check if sync_job exists")
+	}
+
+	return count > 0, nil
+}
+
+// Configuration pointed to by the foreign key.
+func (o *SyncJob) Configuration(mods ...qm.QueryMod) configurationQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ConfigurationID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Configurations(queryMods...)
+}
+
+// LoadConfiguration allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (syncJobL) LoadConfiguration(ctx context.Context, e boil.ContextExecutor, singular bool, maybeSyncJob interface{}, mods queries.Applicator) error {
+	var slice []*SyncJob
+	var object *SyncJob
+
+	if singular {
+		var ok bool
+		object, ok = maybeSyncJob.(*SyncJob)
+		if !ok {
+			object = new(SyncJob)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeSyncJob)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeSyncJob))
+			}
+		}
+	} else {
+		s, ok := maybeSyncJob.(*[]*SyncJob)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeSyncJob)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeSyncJob))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &syncJobR{}
+		}
+		args[object.ConfigurationID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &syncJobR{}
+			}
+
+			args[obj.ConfigurationID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`abb_free_at_home.configuration`),
+		qm.WhereIn(`abb_free_at_home.configuration.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Configuration")
+	}
+
+	var resultSlice []*Configuration
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Configuration")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for configuration")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for configuration")
+	}
+
+	if len(configurationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Configuration = foreign
+		if foreign.R == nil {
+			foreign.R = &configurationR{}
+		}
+		foreign.R.SyncJobs = append(foreign.R.SyncJobs, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ConfigurationID == foreign.ID {
+				local.R.Configuration = foreign
+				if foreign.R == nil {
+					foreign.R = &configurationR{}
+				}
+				foreign.R.SyncJobs = append(foreign.R.SyncJobs, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetConfigurationG of the syncJob to the related item.
+// Sets o.R.Configuration to related.
+// Adds o to related.R.SyncJobs.
+// Uses the global database handle.
+func (o *SyncJob) SetConfigurationG(ctx context.Context, insert bool, related *Configuration) error {
+	return o.SetConfiguration(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetConfiguration of the syncJob to the related item.
+// Sets o.R.Configuration to related.
+// Adds o to related.R.SyncJobs.
+func (o *SyncJob) SetConfiguration(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Configuration) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"abb_free_at_home\".\"sync_job\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"configuration_id"}),
+		strmangle.WhereClause("\"", "\"", 2, syncJobPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ConfigurationID = related.ID
+	if o.R == nil {
+		o.R = &syncJobR{
+			Configuration: related,
+		}
+	} else {
+		o.R.Configuration = related
+	}
+
+	if related.R == nil {
+		related.R = &configurationR{
+			SyncJobs: SyncJobSlice{o},
+		}
+	} else {
+		related.R.SyncJobs = append(related.R.SyncJobs, o)
+	}
+
+	return nil
+}
+
+// SyncJobs retrieves all the records using an executor.
+func SyncJobs(mods ...qm.QueryMod) syncJobQuery {
+	mods = append(mods, qm.From("\"abb_free_at_home\".\"sync_job\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"abb_free_at_home\".\"sync_job\".*"})
+	}
+
+	return syncJobQuery{q}
+}
+
+// FindSyncJobG retrieves a single record by ID.
+func FindSyncJobG(ctx context.Context, iD int64, selectCols ...string) (*SyncJob, error) {
+	return FindSyncJob(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindSyncJob retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindSyncJob(ctx context.Context, exec boil.ContextExecutor, iD int64, selectCols ...string) (*SyncJob, error) {
+	syncJobObj := &SyncJob{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"abb_free_at_home\".\"sync_job\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, syncJobObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "appdb: unable to select from sync_job")
+	}
+
+	if err = syncJobObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return syncJobObj, err
+	}
+
+	return syncJobObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *SyncJob) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *SyncJob) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("appdb: no sync_job provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(syncJobColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	syncJobInsertCacheMut.RLock()
+	cache, cached := syncJobInsertCache[key]
+	syncJobInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			syncJobAllColumns,
+			syncJobColumnsWithDefault,
+			syncJobColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(syncJobType, syncJobMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(syncJobType, syncJobMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"abb_free_at_home\".\"sync_job\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"abb_free_at_home\".\"sync_job\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to insert into sync_job")
+	}
+
+	if !cached {
+		syncJobInsertCacheMut.Lock()
+		syncJobInsertCache[key] = cache
+		syncJobInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single SyncJob record using the global executor.
+// See Update for more documentation.
+func (o *SyncJob) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the SyncJob.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *SyncJob) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	syncJobUpdateCacheMut.RLock()
+	cache, cached := syncJobUpdateCache[key]
+	syncJobUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			syncJobAllColumns,
+			syncJobPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("appdb: unable to update sync_job, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"abb_free_at_home\".\"sync_job\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, syncJobPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(syncJobType, syncJobMapping, append(wl, syncJobPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update sync_job row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by update for sync_job")
+	}
+
+	if !cached {
+		syncJobUpdateCacheMut.Lock()
+		syncJobUpdateCache[key] = cache
+		syncJobUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q syncJobQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q syncJobQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update all for sync_job")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to retrieve rows affected for sync_job")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o SyncJobSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o SyncJobSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("appdb: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), syncJobPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"abb_free_at_home\".\"sync_job\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, syncJobPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update all in syncJob slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to retrieve rows affected all in update all syncJob")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *SyncJob) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *SyncJob) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("appdb: no sync_job provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(syncJobColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	syncJobUpsertCacheMut.RLock()
+	cache, cached := syncJobUpsertCache[key]
+	syncJobUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			syncJobAllColumns,
+			syncJobColumnsWithDefault,
+			syncJobColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			syncJobAllColumns,
+			syncJobPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("appdb: unable to upsert sync_job, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(syncJobAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(syncJobPrimaryKeyColumns) == 0 {
+				return errors.New("appdb: unable to upsert sync_job, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(syncJobPrimaryKeyColumns))
+			copy(conflict, syncJobPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"abb_free_at_home\".\"sync_job\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(syncJobType, syncJobMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(syncJobType, syncJobMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to upsert sync_job")
+	}
+
+	if !cached {
+		syncJobUpsertCacheMut.Lock()
+		syncJobUpsertCache[key] = cache
+		syncJobUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single SyncJob record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *SyncJob) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single SyncJob record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *SyncJob) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("appdb: no SyncJob provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), syncJobPrimaryKeyMapping)
+	sql := "DELETE FROM \"abb_free_at_home\".\"sync_job\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete from sync_job")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by delete for sync_job")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q syncJobQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q syncJobQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("appdb: no syncJobQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete all from sync_job")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by deleteall for sync_job")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o SyncJobSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o SyncJobSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(syncJobBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), syncJobPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"abb_free_at_home\".\"sync_job\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, syncJobPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete all from syncJob slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by deleteall for sync_job")
+	}
+
+	if len(syncJobAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *SyncJob) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("appdb: no SyncJob provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *SyncJob) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindSyncJob(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *SyncJobSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("appdb: empty SyncJobSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *SyncJobSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := SyncJobSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), syncJobPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"abb_free_at_home\".\"sync_job\".* FROM \"abb_free_at_home\".\"sync_job\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, syncJobPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to reload all in SyncJobSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// SyncJobExistsG checks if the SyncJob row exists.
+func SyncJobExistsG(ctx context.Context, iD int64) (bool, error) {
+	return SyncJobExists(ctx, boil.GetContextDB(), iD)
+}
+
+// SyncJobExists checks if the SyncJob row exists.
+func SyncJobExists(ctx context.Context, exec boil.ContextExecutor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"abb_free_at_home\".\"sync_job\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "appdb: unable to check if sync_job exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the SyncJob row exists.
+func (o *SyncJob) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return SyncJobExists(ctx, exec, o.ID)
+}
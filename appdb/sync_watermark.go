@@ -0,0 +1,1215 @@
+// Code generated by SQLBoiler 4.16.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package appdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// SyncWatermark is an object representing the database table.
+type SyncWatermark struct {
+	ConfigurationID int64     `boil:"configuration_id" json:"configuration_id" toml:"configuration_id" yaml:"configuration_id"`
+	SyncedAt        time.Time `boil:"synced_at" json:"synced_at" toml:"synced_at" yaml:"synced_at"`
+
+	R *syncWatermarkR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L syncWatermarkL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var SyncWatermarkColumns = struct {
+	ConfigurationID string
+	SyncedAt        string
+}{
+	ConfigurationID: "configuration_id",
+	SyncedAt:        "synced_at",
+}
+
+var SyncWatermarkTableColumns = struct {
+	ConfigurationID string
+	SyncedAt        string
+}{
+	ConfigurationID: "sync_watermark.configuration_id",
+	SyncedAt:        "sync_watermark.synced_at",
+}
+
+// Generated where
+
+var SyncWatermarkWhere = struct {
+	ConfigurationID whereHelperint64
+	SyncedAt        whereHelpertime_Time
+}{
+	ConfigurationID: whereHelperint64{field: "\"abb_free_at_home\".\"sync_watermark\".\"configuration_id\""},
+	SyncedAt:        whereHelpertime_Time{field: "\"abb_free_at_home\".\"sync_watermark\".\"synced_at\""},
+}
+
+// SyncWatermarkRels is where relationship names are stored.
+var SyncWatermarkRels = struct {
+	Configuration string
+}{
+	Configuration: "Configuration",
+}
+
+// syncWatermarkR is where relationships are stored.
+type syncWatermarkR struct {
+	Configuration *Configuration `boil:"Configuration" json:"Configuration" toml:"Configuration" yaml:"Configuration"`
+}
+
+// NewStruct creates a new relationship struct
+func (*syncWatermarkR) NewStruct() *syncWatermarkR {
+	return &syncWatermarkR{}
+}
+
+func (r *syncWatermarkR) GetConfiguration() *Configuration {
+	if r == nil {
+		return nil
+	}
+	return r.Configuration
+}
+
+// syncWatermarkL is where Load methods for each relationship are stored.
+type syncWatermarkL struct{}
+
+var (
+	syncWatermarkAllColumns            = []string{"configuration_id", "synced_at"}
+	syncWatermarkColumnsWithoutDefault = []string{"configuration_id", "synced_at"}
+	syncWatermarkColumnsWithDefault    = []string{}
+	syncWatermarkPrimaryKeyColumns     = []string{"configuration_id"}
+	syncWatermarkGeneratedColumns      = []string{}
+)
+
+type (
+	// SyncWatermarkSlice is an alias for a slice of pointers to SyncWatermark.
+	// This should almost always be used instead of []SyncWatermark.
+	SyncWatermarkSlice []*SyncWatermark
+	// SyncWatermarkHook is the signature for custom SyncWatermark hook methods
+	SyncWatermarkHook func(context.Context, boil.ContextExecutor, *SyncWatermark) error
+
+	syncWatermarkQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	syncWatermarkType                 = reflect.TypeOf(&SyncWatermark{})
+	syncWatermarkMapping              = queries.MakeStructMapping(syncWatermarkType)
+	syncWatermarkPrimaryKeyMapping, _ = queries.BindMapping(syncWatermarkType, syncWatermarkMapping, syncWatermarkPrimaryKeyColumns)
+	syncWatermarkInsertCacheMut       sync.RWMutex
+	syncWatermarkInsertCache          = make(map[string]insertCache)
+	syncWatermarkUpdateCacheMut       sync.RWMutex
+	syncWatermarkUpdateCache          = make(map[string]updateCache)
+	syncWatermarkUpsertCacheMut       sync.RWMutex
+	syncWatermarkUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var syncWatermarkAfterSelectMu sync.Mutex
+var syncWatermarkAfterSelectHooks []SyncWatermarkHook
+
+var syncWatermarkBeforeInsertMu sync.Mutex
+var syncWatermarkBeforeInsertHooks []SyncWatermarkHook
+var syncWatermarkAfterInsertMu sync.Mutex
+var syncWatermarkAfterInsertHooks []SyncWatermarkHook
+
+var syncWatermarkBeforeUpdateMu sync.Mutex
+var syncWatermarkBeforeUpdateHooks []SyncWatermarkHook
+var syncWatermarkAfterUpdateMu sync.Mutex
+var syncWatermarkAfterUpdateHooks []SyncWatermarkHook
+
+var syncWatermarkBeforeDeleteMu sync.Mutex
+var syncWatermarkBeforeDeleteHooks []SyncWatermarkHook
+var syncWatermarkAfterDeleteMu sync.Mutex
+var syncWatermarkAfterDeleteHooks []SyncWatermarkHook
+
+var syncWatermarkBeforeUpsertMu sync.Mutex
+var syncWatermarkBeforeUpsertHooks []SyncWatermarkHook
+var syncWatermarkAfterUpsertMu sync.Mutex
+var syncWatermarkAfterUpsertHooks []SyncWatermarkHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *SyncWatermark) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range syncWatermarkAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *SyncWatermark) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range syncWatermarkBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *SyncWatermark) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range syncWatermarkAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *SyncWatermark) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range syncWatermarkBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *SyncWatermark) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range syncWatermarkAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *SyncWatermark) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range syncWatermarkBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *SyncWatermark) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range syncWatermarkAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *SyncWatermark) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range syncWatermarkBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *SyncWatermark) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range syncWatermarkAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddSyncWatermarkHook registers your hook function for all future operations.
+func AddSyncWatermarkHook(hookPoint boil.HookPoint, syncWatermarkHook SyncWatermarkHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		syncWatermarkAfterSelectMu.Lock()
+		syncWatermarkAfterSelectHooks = append(syncWatermarkAfterSelectHooks, syncWatermarkHook)
+		syncWatermarkAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		syncWatermarkBeforeInsertMu.Lock()
+		syncWatermarkBeforeInsertHooks = append(syncWatermarkBeforeInsertHooks, syncWatermarkHook)
+		syncWatermarkBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		syncWatermarkAfterInsertMu.Lock()
+		syncWatermarkAfterInsertHooks = append(syncWatermarkAfterInsertHooks, syncWatermarkHook)
+		syncWatermarkAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		syncWatermarkBeforeUpdateMu.Lock()
+		syncWatermarkBeforeUpdateHooks = append(syncWatermarkBeforeUpdateHooks, syncWatermarkHook)
+		syncWatermarkBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		syncWatermarkAfterUpdateMu.Lock()
+		syncWatermarkAfterUpdateHooks = append(syncWatermarkAfterUpdateHooks, syncWatermarkHook)
+		syncWatermarkAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		syncWatermarkBeforeDeleteMu.Lock()
+		syncWatermarkBeforeDeleteHooks = append(syncWatermarkBeforeDeleteHooks, syncWatermarkHook)
+		syncWatermarkBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		syncWatermarkAfterDeleteMu.Lock()
+		syncWatermarkAfterDeleteHooks = append(syncWatermarkAfterDeleteHooks, syncWatermarkHook)
+		syncWatermarkAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		syncWatermarkBeforeUpsertMu.Lock()
+		syncWatermarkBeforeUpsertHooks = append(syncWatermarkBeforeUpsertHooks, syncWatermarkHook)
+		syncWatermarkBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		syncWatermarkAfterUpsertMu.Lock()
+		syncWatermarkAfterUpsertHooks = append(syncWatermarkAfterUpsertHooks, syncWatermarkHook)
+		syncWatermarkAfterUpsertMu.Unlock()
+	}
+}
+
+// OneG returns a single syncWatermark record from the query using the global executor.
+func (q syncWatermarkQuery) OneG(ctx context.Context) (*SyncWatermark, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single syncWatermark record from the query.
+func (q syncWatermarkQuery) One(ctx context.Context, exec boil.ContextExecutor) (*SyncWatermark, error) {
+	o := &SyncWatermark{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "appdb: failed to execute a one query for sync_watermark")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all SyncWatermark records from the query using the global executor.
+func (q syncWatermarkQuery) AllG(ctx context.Context) (SyncWatermarkSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all SyncWatermark records from the query.
+func (q syncWatermarkQuery) All(ctx context.Context, exec boil.ContextExecutor) (SyncWatermarkSlice, error) {
+	var o []*SyncWatermark
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "appdb: failed to assign all query results to SyncWatermark slice")
+	}
+
+	if len(syncWatermarkAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all SyncWatermark records in the query using the global executor
+func (q syncWatermarkQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all SyncWatermark records in the query.
+func (q syncWatermarkQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to count sync_watermark rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q syncWatermarkQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q syncWatermarkQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "appdb: failed to check if sync_watermark exists")
+	}
+
+	return count > 0, nil
+}
+
+// Configuration pointed to by the foreign key.
+func (o *SyncWatermark) Configuration(mods ...qm.QueryMod) configurationQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ConfigurationID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Configurations(queryMods...)
+}
+
+// LoadConfiguration allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (syncWatermarkL) LoadConfiguration(ctx context.Context, e boil.ContextExecutor, singular bool, maybeSyncWatermark interface{}, mods queries.Applicator) error {
+	var slice []*SyncWatermark
+	var object *SyncWatermark
+
+	if singular {
+		var ok bool
+		object, ok = maybeSyncWatermark.(*SyncWatermark)
+		if !ok {
+			object = new(SyncWatermark)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeSyncWatermark)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeSyncWatermark))
+			}
+		}
+	} else {
+		s, ok := maybeSyncWatermark.(*[]*SyncWatermark)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeSyncWatermark)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeSyncWatermark))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &syncWatermarkR{}
+		}
+		args[object.ConfigurationID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &syncWatermarkR{}
+			}
+
+			args[obj.ConfigurationID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`abb_free_at_home.configuration`),
+		qm.WhereIn(`abb_free_at_home.configuration.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Configuration")
+	}
+
+	var resultSlice []*Configuration
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Configuration")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for configuration")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for configuration")
+	}
+
+	if len(configurationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Configuration = foreign
+		if foreign.R == nil {
+			foreign.R = &configurationR{}
+		}
+		foreign.R.SyncWatermark = object
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ConfigurationID == foreign.ID {
+				local.R.Configuration = foreign
+				if foreign.R == nil {
+					foreign.R = &configurationR{}
+				}
+				foreign.R.SyncWatermark = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetConfigurationG of the syncWatermark to the related item.
+// Sets o.R.Configuration to related.
+// Adds o to related.R.SyncWatermark.
+// Uses the global database handle.
+func (o *SyncWatermark) SetConfigurationG(ctx context.Context, insert bool, related *Configuration) error {
+	return o.SetConfiguration(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetConfiguration of the syncWatermark to the related item.
+// Sets o.R.Configuration to related.
+// Adds o to related.R.SyncWatermark.
+func (o *SyncWatermark) SetConfiguration(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Configuration) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"abb_free_at_home\".\"sync_watermark\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"configuration_id"}),
+		strmangle.WhereClause("\"", "\"", 2, syncWatermarkPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ConfigurationID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ConfigurationID = related.ID
+	if o.R == nil {
+		o.R = &syncWatermarkR{
+			Configuration: related,
+		}
+	} else {
+		o.R.Configuration = related
+	}
+
+	if related.R == nil {
+		related.R = &configurationR{
+			SyncWatermark: o,
+		}
+	} else {
+		related.R.SyncWatermark = o
+	}
+
+	return nil
+}
+
+// SyncWatermarks retrieves all the records using an executor.
+func SyncWatermarks(mods ...qm.QueryMod) syncWatermarkQuery {
+	mods = append(mods, qm.From("\"abb_free_at_home\".\"sync_watermark\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"abb_free_at_home\".\"sync_watermark\".*"})
+	}
+
+	return syncWatermarkQuery{q}
+}
+
+// FindSyncWatermarkG retrieves a single record by ID.
+func FindSyncWatermarkG(ctx context.Context, configurationID int64, selectCols ...string) (*SyncWatermark, error) {
+	return FindSyncWatermark(ctx, boil.GetContextDB(), configurationID, selectCols...)
+}
+
+// FindSyncWatermark retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindSyncWatermark(ctx context.Context, exec boil.ContextExecutor, configurationID int64, selectCols ...string) (*SyncWatermark, error) {
+	syncWatermarkObj := &SyncWatermark{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"abb_free_at_home\".\"sync_watermark\" where \"configuration_id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, configurationID)
+
+	err := q.Bind(ctx, exec, syncWatermarkObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "appdb: unable to select from sync_watermark")
+	}
+
+	if err = syncWatermarkObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return syncWatermarkObj, err
+	}
+
+	return syncWatermarkObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *SyncWatermark) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *SyncWatermark) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("appdb: no sync_watermark provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(syncWatermarkColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	syncWatermarkInsertCacheMut.RLock()
+	cache, cached := syncWatermarkInsertCache[key]
+	syncWatermarkInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			syncWatermarkAllColumns,
+			syncWatermarkColumnsWithDefault,
+			syncWatermarkColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(syncWatermarkType, syncWatermarkMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(syncWatermarkType, syncWatermarkMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"abb_free_at_home\".\"sync_watermark\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"abb_free_at_home\".\"sync_watermark\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to insert into sync_watermark")
+	}
+
+	if !cached {
+		syncWatermarkInsertCacheMut.Lock()
+		syncWatermarkInsertCache[key] = cache
+		syncWatermarkInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single SyncWatermark record using the global executor.
+// See Update for more documentation.
+func (o *SyncWatermark) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the SyncWatermark.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *SyncWatermark) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	syncWatermarkUpdateCacheMut.RLock()
+	cache, cached := syncWatermarkUpdateCache[key]
+	syncWatermarkUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			syncWatermarkAllColumns,
+			syncWatermarkPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("appdb: unable to update sync_watermark, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"abb_free_at_home\".\"sync_watermark\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, syncWatermarkPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(syncWatermarkType, syncWatermarkMapping, append(wl, syncWatermarkPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update sync_watermark row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by update for sync_watermark")
+	}
+
+	if !cached {
+		syncWatermarkUpdateCacheMut.Lock()
+		syncWatermarkUpdateCache[key] = cache
+		syncWatermarkUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q syncWatermarkQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q syncWatermarkQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update all for sync_watermark")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to retrieve rows affected for sync_watermark")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o SyncWatermarkSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o SyncWatermarkSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("appdb: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), syncWatermarkPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"abb_free_at_home\".\"sync_watermark\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, syncWatermarkPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update all in syncWatermark slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to retrieve rows affected all in update all syncWatermark")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *SyncWatermark) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *SyncWatermark) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("appdb: no sync_watermark provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(syncWatermarkColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	syncWatermarkUpsertCacheMut.RLock()
+	cache, cached := syncWatermarkUpsertCache[key]
+	syncWatermarkUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			syncWatermarkAllColumns,
+			syncWatermarkColumnsWithDefault,
+			syncWatermarkColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			syncWatermarkAllColumns,
+			syncWatermarkPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("appdb: unable to upsert sync_watermark, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(syncWatermarkAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(syncWatermarkPrimaryKeyColumns) == 0 {
+				return errors.New("appdb: unable to upsert sync_watermark, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(syncWatermarkPrimaryKeyColumns))
+			copy(conflict, syncWatermarkPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"abb_free_at_home\".\"sync_watermark\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(syncWatermarkType, syncWatermarkMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(syncWatermarkType, syncWatermarkMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to upsert sync_watermark")
+	}
+
+	if !cached {
+		syncWatermarkUpsertCacheMut.Lock()
+		syncWatermarkUpsertCache[key] = cache
+		syncWatermarkUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single SyncWatermark record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *SyncWatermark) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single SyncWatermark record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *SyncWatermark) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("appdb: no SyncWatermark provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), syncWatermarkPrimaryKeyMapping)
+	sql := "DELETE FROM \"abb_free_at_home\".\"sync_watermark\" WHERE \"configuration_id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete from sync_watermark")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by delete for sync_watermark")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q syncWatermarkQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q syncWatermarkQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("appdb: no syncWatermarkQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete all from sync_watermark")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by deleteall for sync_watermark")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o SyncWatermarkSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o SyncWatermarkSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(syncWatermarkBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), syncWatermarkPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"abb_free_at_home\".\"sync_watermark\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, syncWatermarkPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete all from syncWatermark slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by deleteall for sync_watermark")
+	}
+
+	if len(syncWatermarkAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *SyncWatermark) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("appdb: no SyncWatermark provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *SyncWatermark) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindSyncWatermark(ctx, exec, o.ConfigurationID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *SyncWatermarkSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("appdb: empty SyncWatermarkSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *SyncWatermarkSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := SyncWatermarkSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), syncWatermarkPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"abb_free_at_home\".\"sync_watermark\".* FROM \"abb_free_at_home\".\"sync_watermark\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, syncWatermarkPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to reload all in SyncWatermarkSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// SyncWatermarkExistsG checks if the SyncWatermark row exists.
+func SyncWatermarkExistsG(ctx context.Context, configurationID int64) (bool, error) {
+	return SyncWatermarkExists(ctx, boil.GetContextDB(), configurationID)
+}
+
+// SyncWatermarkExists checks if the SyncWatermark row exists.
+func SyncWatermarkExists(ctx context.Context, exec boil.ContextExecutor, configurationID int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"abb_free_at_home\".\"sync_watermark\" where \"configuration_id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, configurationID)
+	}
+	row := exec.QueryRowContext(ctx, sql, configurationID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "appdb: unable to check if sync_watermark exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the SyncWatermark row exists.
+func (o *SyncWatermark) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return SyncWatermarkExists(ctx, exec, o.ConfigurationID)
+}
@@ -0,0 +1,1244 @@
+// Code generated by SQLBoiler 4.16.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package appdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// SystemConnectionEvent is an object representing the database table.
+type SystemConnectionEvent struct {
+	ID              int64     `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ConfigurationID int64     `boil:"configuration_id" json:"configuration_id" toml:"configuration_id" yaml:"configuration_id"`
+	SystemID        string    `boil:"system_id" json:"system_id" toml:"system_id" yaml:"system_id"`
+	Connected       bool      `boil:"connected" json:"connected" toml:"connected" yaml:"connected"`
+	ChangedAt       time.Time `boil:"changed_at" json:"changed_at" toml:"changed_at" yaml:"changed_at"`
+	NotifiedAt      null.Time `boil:"notified_at" json:"notified_at,omitempty" toml:"notified_at" yaml:"notified_at,omitempty"`
+
+	R *systemConnectionEventR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L systemConnectionEventL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var SystemConnectionEventColumns = struct {
+	ID              string
+	ConfigurationID string
+	SystemID        string
+	Connected       string
+	ChangedAt       string
+	NotifiedAt      string
+}{
+	ID:              "id",
+	ConfigurationID: "configuration_id",
+	SystemID:        "system_id",
+	Connected:       "connected",
+	ChangedAt:       "changed_at",
+	NotifiedAt:      "notified_at",
+}
+
+var SystemConnectionEventTableColumns = struct {
+	ID              string
+	ConfigurationID string
+	SystemID        string
+	Connected       string
+	ChangedAt       string
+	NotifiedAt      string
+}{
+	ID:              "system_connection_event.id",
+	ConfigurationID: "system_connection_event.configuration_id",
+	SystemID:        "system_connection_event.system_id",
+	Connected:       "system_connection_event.connected",
+	ChangedAt:       "system_connection_event.changed_at",
+	NotifiedAt:      "system_connection_event.notified_at",
+}
+
+// Generated where
+
+var SystemConnectionEventWhere = struct {
+	ID              whereHelperint64
+	ConfigurationID whereHelperint64
+	SystemID        whereHelperstring
+	Connected       whereHelperbool
+	ChangedAt       whereHelpertime_Time
+	NotifiedAt      whereHelpernull_Time
+}{
+	ID:              whereHelperint64{field: "\"abb_free_at_home\".\"system_connection_event\".\"id\""},
+	ConfigurationID: whereHelperint64{field: "\"abb_free_at_home\".\"system_connection_event\".\"configuration_id\""},
+	SystemID:        whereHelperstring{field: "\"abb_free_at_home\".\"system_connection_event\".\"system_id\""},
+	Connected:       whereHelperbool{field: "\"abb_free_at_home\".\"system_connection_event\".\"connected\""},
+	ChangedAt:       whereHelpertime_Time{field: "\"abb_free_at_home\".\"system_connection_event\".\"changed_at\""},
+	NotifiedAt:      whereHelpernull_Time{field: "\"abb_free_at_home\".\"system_connection_event\".\"notified_at\""},
+}
+
+// SystemConnectionEventRels is where relationship names are stored.
+var SystemConnectionEventRels = struct {
+	Configuration string
+}{
+	Configuration: "Configuration",
+}
+
+// systemConnectionEventR is where relationships are stored.
+type systemConnectionEventR struct {
+	Configuration *Configuration `boil:"Configuration" json:"Configuration" toml:"Configuration" yaml:"Configuration"`
+}
+
+// NewStruct creates a new relationship struct
+func (*systemConnectionEventR) NewStruct() *systemConnectionEventR {
+	return &systemConnectionEventR{}
+}
+
+func (r *systemConnectionEventR) GetConfiguration() *Configuration {
+	if r == nil {
+		return nil
+	}
+	return r.Configuration
+}
+
+// systemConnectionEventL is where Load methods for each relationship are stored.
+type systemConnectionEventL struct{}
+
+var (
+	systemConnectionEventAllColumns            = []string{"id", "configuration_id", "system_id", "connected", "changed_at", "notified_at"}
+	systemConnectionEventColumnsWithoutDefault = []string{"configuration_id", "system_id", "connected"}
+	systemConnectionEventColumnsWithDefault    = []string{"id", "changed_at", "notified_at"}
+	systemConnectionEventPrimaryKeyColumns     = []string{"id"}
+	systemConnectionEventGeneratedColumns      = []string{}
+)
+
+type (
+	// SystemConnectionEventSlice is an alias for a slice of pointers to SystemConnectionEvent.
+	// This should almost always be used instead of []SystemConnectionEvent.
+	SystemConnectionEventSlice []*SystemConnectionEvent
+	// SystemConnectionEventHook is the signature for custom SystemConnectionEvent hook methods
+	SystemConnectionEventHook func(context.Context, boil.ContextExecutor, *SystemConnectionEvent) error
+
+	systemConnectionEventQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	systemConnectionEventType                 = reflect.TypeOf(&SystemConnectionEvent{})
+	systemConnectionEventMapping              = queries.MakeStructMapping(systemConnectionEventType)
+	systemConnectionEventPrimaryKeyMapping, _ = queries.BindMapping(systemConnectionEventType, systemConnectionEventMapping, systemConnectionEventPrimaryKeyColumns)
+	systemConnectionEventInsertCacheMut       sync.RWMutex
+	systemConnectionEventInsertCache          = make(map[string]insertCache)
+	systemConnectionEventUpdateCacheMut       sync.RWMutex
+	systemConnectionEventUpdateCache          = make(map[string]updateCache)
+	systemConnectionEventUpsertCacheMut       sync.RWMutex
+	systemConnectionEventUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var systemConnectionEventAfterSelectMu sync.Mutex
+var systemConnectionEventAfterSelectHooks []SystemConnectionEventHook
+
+var systemConnectionEventBeforeInsertMu sync.Mutex
+var systemConnectionEventBeforeInsertHooks []SystemConnectionEventHook
+var systemConnectionEventAfterInsertMu sync.Mutex
+var systemConnectionEventAfterInsertHooks []SystemConnectionEventHook
+
+var systemConnectionEventBeforeUpdateMu sync.Mutex
+var systemConnectionEventBeforeUpdateHooks []SystemConnectionEventHook
+var systemConnectionEventAfterUpdateMu sync.Mutex
+var systemConnectionEventAfterUpdateHooks []SystemConnectionEventHook
+
+var systemConnectionEventBeforeDeleteMu sync.Mutex
+var systemConnectionEventBeforeDeleteHooks []SystemConnectionEventHook
+var systemConnectionEventAfterDeleteMu sync.Mutex
+var systemConnectionEventAfterDeleteHooks []SystemConnectionEventHook
+
+var systemConnectionEventBeforeUpsertMu sync.Mutex
+var systemConnectionEventBeforeUpsertHooks []SystemConnectionEventHook
+var systemConnectionEventAfterUpsertMu sync.Mutex
+var systemConnectionEventAfterUpsertHooks []SystemConnectionEventHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *SystemConnectionEvent) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range systemConnectionEventAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *SystemConnectionEvent) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range systemConnectionEventBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *SystemConnectionEvent) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range systemConnectionEventAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *SystemConnectionEvent) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range systemConnectionEventBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *SystemConnectionEvent) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range systemConnectionEventAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *SystemConnectionEvent) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range systemConnectionEventBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *SystemConnectionEvent) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range systemConnectionEventAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *SystemConnectionEvent) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range systemConnectionEventBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *SystemConnectionEvent) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range systemConnectionEventAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddSystemConnectionEventHook registers your hook function for all future operations.
+func AddSystemConnectionEventHook(hookPoint boil.HookPoint, systemConnectionEventHook SystemConnectionEventHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		systemConnectionEventAfterSelectMu.Lock()
+		systemConnectionEventAfterSelectHooks = append(systemConnectionEventAfterSelectHooks, systemConnectionEventHook)
+		systemConnectionEventAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		systemConnectionEventBeforeInsertMu.Lock()
+		systemConnectionEventBeforeInsertHooks = append(systemConnectionEventBeforeInsertHooks, systemConnectionEventHook)
+		systemConnectionEventBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		systemConnectionEventAfterInsertMu.Lock()
+		systemConnectionEventAfterInsertHooks = append(systemConnectionEventAfterInsertHooks, systemConnectionEventHook)
+		systemConnectionEventAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		systemConnectionEventBeforeUpdateMu.Lock()
+		systemConnectionEventBeforeUpdateHooks = append(systemConnectionEventBeforeUpdateHooks, systemConnectionEventHook)
+		systemConnectionEventBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		systemConnectionEventAfterUpdateMu.Lock()
+		systemConnectionEventAfterUpdateHooks = append(systemConnectionEventAfterUpdateHooks, systemConnectionEventHook)
+		systemConnectionEventAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		systemConnectionEventBeforeDeleteMu.Lock()
+		systemConnectionEventBeforeDeleteHooks = append(systemConnectionEventBeforeDeleteHooks, systemConnectionEventHook)
+		systemConnectionEventBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		systemConnectionEventAfterDeleteMu.Lock()
+		systemConnectionEventAfterDeleteHooks = append(systemConnectionEventAfterDeleteHooks, systemConnectionEventHook)
+		systemConnectionEventAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		systemConnectionEventBeforeUpsertMu.Lock()
+		systemConnectionEventBeforeUpsertHooks = append(systemConnectionEventBeforeUpsertHooks, systemConnectionEventHook)
+		systemConnectionEventBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		systemConnectionEventAfterUpsertMu.Lock()
+		systemConnectionEventAfterUpsertHooks = append(systemConnectionEventAfterUpsertHooks, systemConnectionEventHook)
+		systemConnectionEventAfterUpsertMu.Unlock()
+	}
+}
+
+// OneG returns a single systemConnectionEvent record from the query using the global executor.
+func (q systemConnectionEventQuery) OneG(ctx context.Context) (*SystemConnectionEvent, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single systemConnectionEvent record from the query.
+func (q systemConnectionEventQuery) One(ctx context.Context, exec boil.ContextExecutor) (*SystemConnectionEvent, error) {
+	o := &SystemConnectionEvent{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "appdb: failed to execute a one query for system_connection_event")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all SystemConnectionEvent records from the query using the global executor.
+func (q systemConnectionEventQuery) AllG(ctx context.Context) (SystemConnectionEventSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all SystemConnectionEvent records from the query.
+func (q systemConnectionEventQuery) All(ctx context.Context, exec boil.ContextExecutor) (SystemConnectionEventSlice, error) {
+	var o []*SystemConnectionEvent
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "appdb: failed to assign all query results to SystemConnectionEvent slice")
+	}
+
+	if len(systemConnectionEventAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all SystemConnectionEvent records in the query using the global executor
+func (q systemConnectionEventQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all SystemConnectionEvent records in the query.
+func (q systemConnectionEventQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to count system_connection_event rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q systemConnectionEventQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q systemConnectionEventQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "appdb: failed to check if system_connection_event exists")
+	}
+
+	return count > 0, nil
+}
+
+// Configuration pointed to by the foreign key.
+func (o *SystemConnectionEvent) Configuration(mods ...qm.QueryMod) configurationQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ConfigurationID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Configurations(queryMods...)
+}
+
+// LoadConfiguration allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (systemConnectionEventL) LoadConfiguration(ctx context.Context, e boil.ContextExecutor, singular bool, maybeSystemConnectionEvent interface{}, mods queries.Applicator) error {
+	var slice []*SystemConnectionEvent
+	var object *SystemConnectionEvent
+
+	if singular {
+		var ok bool
+		object, ok = maybeSystemConnectionEvent.(*SystemConnectionEvent)
+		if !ok {
+			object = new(SystemConnectionEvent)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeSystemConnectionEvent)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeSystemConnectionEvent))
+			}
+		}
+	} else {
+		s, ok := maybeSystemConnectionEvent.(*[]*SystemConnectionEvent)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeSystemConnectionEvent)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeSystemConnectionEvent))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &systemConnectionEventR{}
+		}
+		args[object.ConfigurationID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &systemConnectionEventR{}
+			}
+
+			args[obj.ConfigurationID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`abb_free_at_home.configuration`),
+		qm.WhereIn(`abb_free_at_home.configuration.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Configuration")
+	}
+
+	var resultSlice []*Configuration
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Configuration")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for configuration")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for configuration")
+	}
+
+	if len(configurationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Configuration = foreign
+		if foreign.R == nil {
+			foreign.R = &configurationR{}
+		}
+		foreign.R.SystemConnectionEvents = append(foreign.R.SystemConnectionEvents, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ConfigurationID == foreign.ID {
+				local.R.Configuration = foreign
+				if foreign.R == nil {
+					foreign.R = &configurationR{}
+				}
+				foreign.R.SystemConnectionEvents = append(foreign.R.SystemConnectionEvents, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetConfigurationG of the systemConnectionEvent to the related item.
+// Sets o.R.Configuration to related.
+// Adds o to related.R.SystemConnectionEvents.
+// Uses the global database handle.
+func (o *SystemConnectionEvent) SetConfigurationG(ctx context.Context, insert bool, related *Configuration) error {
+	return o.SetConfiguration(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetConfiguration of the systemConnectionEvent to the related item.
+// Sets o.R.Configuration to related.
+// Adds o to related.R.SystemConnectionEvents.
+func (o *SystemConnectionEvent) SetConfiguration(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Configuration) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"abb_free_at_home\".\"system_connection_event\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"configuration_id"}),
+		strmangle.WhereClause("\"", "\"", 2, systemConnectionEventPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ConfigurationID = related.ID
+	if o.R == nil {
+		o.R = &systemConnectionEventR{
+			Configuration: related,
+		}
+	} else {
+		o.R.Configuration = related
+	}
+
+	if related.R == nil {
+		related.R = &configurationR{
+			SystemConnectionEvents: SystemConnectionEventSlice{o},
+		}
+	} else {
+		related.R.SystemConnectionEvents = append(related.R.SystemConnectionEvents, o)
+	}
+
+	return nil
+}
+
+// SystemConnectionEvents retrieves all the records using an executor.
+func SystemConnectionEvents(mods ...qm.QueryMod) systemConnectionEventQuery {
+	mods = append(mods, qm.From("\"abb_free_at_home\".\"system_connection_event\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"abb_free_at_home\".\"system_connection_event\".*"})
+	}
+
+	return systemConnectionEventQuery{q}
+}
+
+// FindSystemConnectionEventG retrieves a single record by ID.
+func FindSystemConnectionEventG(ctx context.Context, iD int64, selectCols ...string) (*SystemConnectionEvent, error) {
+	return FindSystemConnectionEvent(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindSystemConnectionEvent retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindSystemConnectionEvent(ctx context.Context, exec boil.ContextExecutor, iD int64, selectCols ...string) (*SystemConnectionEvent, error) {
+	systemConnectionEventObj := &SystemConnectionEvent{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"abb_free_at_home\".\"system_connection_event\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, systemConnectionEventObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "appdb: unable to select from system_connection_event")
+	}
+
+	if err = systemConnectionEventObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return systemConnectionEventObj, err
+	}
+
+	return systemConnectionEventObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *SystemConnectionEvent) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *SystemConnectionEvent) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("appdb: no system_connection_event provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(systemConnectionEventColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	systemConnectionEventInsertCacheMut.RLock()
+	cache, cached := systemConnectionEventInsertCache[key]
+	systemConnectionEventInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			systemConnectionEventAllColumns,
+			systemConnectionEventColumnsWithDefault,
+			systemConnectionEventColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(systemConnectionEventType, systemConnectionEventMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(systemConnectionEventType, systemConnectionEventMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"abb_free_at_home\".\"system_connection_event\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"abb_free_at_home\".\"system_connection_event\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to insert into system_connection_event")
+	}
+
+	if !cached {
+		systemConnectionEventInsertCacheMut.Lock()
+		systemConnectionEventInsertCache[key] = cache
+		systemConnectionEventInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single SystemConnectionEvent record using the global executor.
+// See Update for more documentation.
+func (o *SystemConnectionEvent) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the SystemConnectionEvent.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *SystemConnectionEvent) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	systemConnectionEventUpdateCacheMut.RLock()
+	cache, cached := systemConnectionEventUpdateCache[key]
+	systemConnectionEventUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			systemConnectionEventAllColumns,
+			systemConnectionEventPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("appdb: unable to update system_connection_event, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"abb_free_at_home\".\"system_connection_event\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, systemConnectionEventPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(systemConnectionEventType, systemConnectionEventMapping, append(wl, systemConnectionEventPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update system_connection_event row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by update for system_connection_event")
+	}
+
+	if !cached {
+		systemConnectionEventUpdateCacheMut.Lock()
+		systemConnectionEventUpdateCache[key] = cache
+		systemConnectionEventUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q systemConnectionEventQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q systemConnectionEventQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update all for system_connection_event")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to retrieve rows affected for system_connection_event")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o SystemConnectionEventSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o SystemConnectionEventSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("appdb: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), systemConnectionEventPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"abb_free_at_home\".\"system_connection_event\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, systemConnectionEventPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to update all in systemConnectionEvent slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to retrieve rows affected all in update all systemConnectionEvent")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *SystemConnectionEvent) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *SystemConnectionEvent) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("appdb: no system_connection_event provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(systemConnectionEventColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	systemConnectionEventUpsertCacheMut.RLock()
+	cache, cached := systemConnectionEventUpsertCache[key]
+	systemConnectionEventUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			systemConnectionEventAllColumns,
+			systemConnectionEventColumnsWithDefault,
+			systemConnectionEventColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			systemConnectionEventAllColumns,
+			systemConnectionEventPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("appdb: unable to upsert system_connection_event, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(systemConnectionEventAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(systemConnectionEventPrimaryKeyColumns) == 0 {
+				return errors.New("appdb: unable to upsert system_connection_event, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(systemConnectionEventPrimaryKeyColumns))
+			copy(conflict, systemConnectionEventPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"abb_free_at_home\".\"system_connection_event\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(systemConnectionEventType, systemConnectionEventMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(systemConnectionEventType, systemConnectionEventMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to upsert system_connection_event")
+	}
+
+	if !cached {
+		systemConnectionEventUpsertCacheMut.Lock()
+		systemConnectionEventUpsertCache[key] = cache
+		systemConnectionEventUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single SystemConnectionEvent record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *SystemConnectionEvent) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single SystemConnectionEvent record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *SystemConnectionEvent) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("appdb: no SystemConnectionEvent provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), systemConnectionEventPrimaryKeyMapping)
+	sql := "DELETE FROM \"abb_free_at_home\".\"system_connection_event\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete from system_connection_event")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by delete for system_connection_event")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q systemConnectionEventQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q systemConnectionEventQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("appdb: no systemConnectionEventQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete all from system_connection_event")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by deleteall for system_connection_event")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o SystemConnectionEventSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o SystemConnectionEventSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(systemConnectionEventBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), systemConnectionEventPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"abb_free_at_home\".\"system_connection_event\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, systemConnectionEventPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: unable to delete all from systemConnectionEvent slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "appdb: failed to get rows affected by deleteall for system_connection_event")
+	}
+
+	if len(systemConnectionEventAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *SystemConnectionEvent) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("appdb: no SystemConnectionEvent provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *SystemConnectionEvent) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindSystemConnectionEvent(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *SystemConnectionEventSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("appdb: empty SystemConnectionEventSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *SystemConnectionEventSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := SystemConnectionEventSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), systemConnectionEventPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"abb_free_at_home\".\"system_connection_event\".* FROM \"abb_free_at_home\".\"system_connection_event\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, systemConnectionEventPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "appdb: unable to reload all in SystemConnectionEventSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// SystemConnectionEventExistsG checks if the SystemConnectionEvent row exists.
+func SystemConnectionEventExistsG(ctx context.Context, iD int64) (bool, error) {
+	return SystemConnectionEventExists(ctx, boil.GetContextDB(), iD)
+}
+
+// SystemConnectionEventExists checks if the SystemConnectionEvent row exists.
+func SystemConnectionEventExists(ctx context.Context, exec boil.ContextExecutor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"abb_free_at_home\".\"system_connection_event\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "appdb: unable to check if system_connection_event exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the SystemConnectionEvent row exists.
+func (o *SystemConnectionEvent) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return SystemConnectionEventExists(ctx, exec, o.ID)
+}
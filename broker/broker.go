@@ -26,8 +26,10 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	elionaapi "github.com/eliona-smart-building-assistant/go-eliona-api-client/v2"
+	"github.com/eliona-smart-building-assistant/go-utils/common"
 	"github.com/eliona-smart-building-assistant/go-utils/log"
 )
 
@@ -55,13 +57,62 @@ const (
 	function_set_scene             = "set_scene"
 	function_mute_button           = "mute_button"
 	function_floor_call            = "floor_call"
+	function_door_opener           = "door_opener"
+	function_automatic_door_opener = "automatic_door_opener"
+	function_staircase_light       = "staircase_light"
+	function_ring                  = "ring"
 	function_installed_power       = "installed_power"
 	function_total_energy          = "total_energy"
 	function_start_last_charging   = "start_last_charging"
 )
 
 const SET_TEMP_TWICE = function_set_temperature
-const SET_SCENE_RETURN_TO_ZERO = function_set_scene
+const NOTIFY_ON_RING = function_ring
+
+// Momentary declares that a function acts as a trigger: after a value is written or reported,
+// the attribute returns to ResetValue once Delay passed. ResetABB also writes the reset value to
+// the ABB input, for inputs that would otherwise keep the triggered state.
+type Momentary struct {
+	ResetValue float64
+	Delay      time.Duration
+	ResetABB   bool
+}
+
+var momentaryFunctions = map[string]Momentary{
+	// Scenes are stateless, the trigger is only simulated in Eliona.
+	function_set_scene: {ResetValue: 0},
+	// ABB times the call itself.
+	function_floor_call:  {ResetValue: 0, Delay: time.Second},
+	function_mute_button: {ResetValue: 0, Delay: time.Second, ResetABB: true},
+	// ABB times the door opening and the staircase light itself.
+	function_door_opener:     {ResetValue: 0, Delay: time.Second},
+	function_staircase_light: {ResetValue: 0, Delay: time.Second},
+	// A ring is an event, ABB does not always report its end.
+	function_ring: {ResetValue: 0, Delay: 5 * time.Second},
+}
+
+// MomentaryFunction returns the momentary behaviour of the function, if it has one.
+func MomentaryFunction(function string) (Momentary, bool) {
+	momentary, ok := momentaryFunctions[function]
+	return momentary, ok
+}
+
+// ClearHeldRings sets the ringing sensors to their reset value. Rings are shown from the
+// reported changes only, the periodic refresh would otherwise bring back a ring ABB still holds.
+func ClearHeldRings(systems []model.System) {
+	reset := int8(momentaryFunctions[function_ring].ResetValue)
+	for si := range systems {
+		for di := range systems[si].Devices {
+			channels := systems[si].Devices[di].Channels
+			for ci := range channels {
+				if sensor, ok := channels[ci].(model.DoorRingingSensor); ok {
+					sensor.Ring = reset
+					channels[ci] = sensor
+				}
+			}
+		}
+	}
+}
 
 var Functions = []string{
 	function_status,
@@ -87,6 +138,10 @@ var Functions = []string{
 	function_set_scene,
 	function_mute_button,
 	function_floor_call,
+	function_door_opener,
+	function_automatic_door_opener,
+	function_staircase_light,
+	function_ring,
 	function_installed_power,
 	function_total_energy,
 	function_start_last_charging,
@@ -143,9 +198,10 @@ func GetLocations(config *apiserver.Configuration) ([]model.Floor, error) {
 	for _, system := range abbLocations.ISystemFH {
 		for _, floor := range system.Locations {
 			f := model.Floor{
-				Id:    floor.DtId,
-				Name:  floor.Label,
-				Level: floor.Level,
+				Id:       floor.DtId,
+				SystemID: system.DtId,
+				Name:     floor.Label,
+				Level:    floor.Level,
 			}
 			for _, room := range floor.Sublocations {
 				r := model.Room{
@@ -162,7 +218,9 @@ func GetLocations(config *apiserver.Configuration) ([]model.Floor, error) {
 
 // GetSystems gets systems according to the configuration passed.
 // The systems are then converted to a model.System type and the datapoints are mapped here.
-func GetSystems(config *apiserver.Configuration) ([]model.System, error) {
+// Channels not adhering to the asset filter are left out. The locations are used to filter
+// by floor and room.
+func GetSystems(config *apiserver.Configuration, locations []model.Floor) ([]model.System, error) {
 	api, err := getAPI(config)
 	if err != nil {
 		return nil, fmt.Errorf("getting API instance: %v", err)
@@ -177,6 +235,7 @@ func GetSystems(config *apiserver.Configuration) ([]model.System, error) {
 		return nil, fmt.Errorf("getting configuration: %v", err)
 	}
 
+	language := common.Val(config.Language)
 	var systems []model.System
 	for id, system := range abbConfiguration.Systems {
 		connectionStatus := int8(0)
@@ -188,20 +247,39 @@ func GetSystems(config *apiserver.Configuration) ([]model.System, error) {
 			GAI:              id,
 			Name:             system.SysApName,
 			ConnectionStatus: connectionStatus,
-		}
-		if adheres, err := s.AdheresToFilter(config.AssetFilter); err != nil {
-			return nil, fmt.Errorf("determining whether system adheres to a filter: %v", err)
-		} else if !adheres {
-			continue
+			ConnectionKnown:  system.ConnectionKnown,
+			FirmwareVersion:  system.FirmwareVersion,
+			Version:          system.Version,
 		}
 		for id, device := range system.Devices {
 			d := model.Device{
-				ID:           id,
-				GAI:          s.GAI + "_" + id,
-				Name:         device.DisplayName.(string),
-				Location:     device.Location,
-				Battery:      device.Battery,
-				Connectivity: device.Connectivity,
+				ID:            id,
+				GAI:           s.GAI + "_" + id,
+				Name:          model.Localized(device.Names, language, device.DisplayName.(string)),
+				Names:         device.Names,
+				Location:      device.Location,
+				Battery:       device.Battery,
+				Connectivity:  device.Connectivity,
+				SignalQuality: model.ParseSignalQuality(device.Connectivity),
+
+				ArticleNumber:   device.ArticleNumber,
+				DeviceType:      device.DeviceType,
+				FirmwareVersion: device.FirmwareVersion,
+				Manufacturer:    device.Manufacturer,
+				SerialNumber:    device.SerialNumber,
+				Connection:      device.Connection,
+				LastSeen:        device.LastSeen,
+			}
+			if d.SignalQuality != nil {
+				reachable := int8(0)
+				if *d.SignalQuality > 0 {
+					reachable = 1
+				}
+				d.Reachable = &reachable
+			}
+			var floorName, floorLevel, roomName string
+			if floor, room := model.FindRoom(locations, device.Location); room != nil {
+				floorName, floorLevel, roomName = floor.Name, floor.Level, room.Name
 			}
 			for id, channel := range device.Channels {
 				if channel.FunctionId == "" {
@@ -214,10 +292,16 @@ func GetSystems(config *apiserver.Configuration) ([]model.System, error) {
 					log.Error("broker", "parsing functionID %s: %v", channel.FunctionId, err)
 					continue
 				}
+				functionName := model.Localized(channel.FunctionNames, language, channel.FunctionName)
+				if functionName == "" {
+					functionName = channel.FunctionId
+				}
 				assetBase := model.AssetBase{
-					IDBase:   id,
-					GAIBase:  d.GAI + "_" + id,
-					NameBase: channel.DisplayName.(string),
+					IDBase:       id,
+					GAIBase:      d.GAI + "_" + id,
+					NameBase:     model.Localized(channel.Names, language, channel.DisplayName.(string)),
+					NamesBase:    channel.Names,
+					FunctionBase: functionName,
 				}
 				switch fid {
 				case model.FID_SWITCH_ACTUATOR:
@@ -226,7 +310,10 @@ func GetSystems(config *apiserver.Configuration) ([]model.System, error) {
 					for datapoint, output := range channel.Outputs {
 						if output.PairingId == model.PID_ON_OFF_INFO_GET {
 							outputs[function_switch] = model.Datapoint{
-								Name: datapoint,
+								Name:  datapoint,
+								Value: output.Value,
+								Dpt:   output.Dpt,
+								Time:  output.Time,
 								Map: model.DatapointMap{
 									{
 										Subtype:       elionaapi.SUBTYPE_OUTPUT,
@@ -258,7 +345,10 @@ func GetSystems(config *apiserver.Configuration) ([]model.System, error) {
 						switch output.PairingId {
 						case model.PID_ON_OFF_INFO_GET:
 							outputs[function_switch] = model.Datapoint{
-								Name: datapoint,
+								Name:  datapoint,
+								Value: output.Value,
+								Dpt:   output.Dpt,
+								Time:  output.Time,
 								Map: model.DatapointMap{
 									{
 										Subtype:       elionaapi.SUBTYPE_OUTPUT,
@@ -268,7 +358,10 @@ func GetSystems(config *apiserver.Configuration) ([]model.System, error) {
 							}
 						case model.PID_ACTUAL_DIM_VALUE_0_100_GET:
 							outputs[function_dimmer] = model.Datapoint{
-								Name: datapoint,
+								Name:  datapoint,
+								Value: output.Value,
+								Dpt:   output.Dpt,
+								Time:  output.Time,
 								Map: model.DatapointMap{
 									{
 										Subtype:       elionaapi.SUBTYPE_OUTPUT,
@@ -304,7 +397,10 @@ func GetSystems(config *apiserver.Configuration) ([]model.System, error) {
 						switch output.PairingId {
 						case model.PID_ON_OFF_INFO_GET:
 							outputs[function_switch] = model.Datapoint{
-								Name: datapoint,
+								Name:  datapoint,
+								Value: output.Value,
+								Dpt:   output.Dpt,
+								Time:  output.Time,
 								Map: model.DatapointMap{
 									{
 										Subtype:       elionaapi.SUBTYPE_OUTPUT,
@@ -314,7 +410,10 @@ func GetSystems(config *apiserver.Configuration) ([]model.System, error) {
 							}
 						case model.PID_ACTUAL_DIM_VALUE_0_100_GET:
 							outputs[function_dimmer] = model.Datapoint{
-								Name: datapoint,
+								Name:  datapoint,
+								Value: output.Value,
+								Dpt:   output.Dpt,
+								Time:  output.Time,
 								Map: model.DatapointMap{
 									{
 										Subtype:       elionaapi.SUBTYPE_OUTPUT,
@@ -324,7 +423,10 @@ func GetSystems(config *apiserver.Configuration) ([]model.System, error) {
 							}
 						case model.PID_HSV_COLOR_GET:
 							outputs[function_hsv] = model.Datapoint{
-								Name: datapoint,
+								Name:  datapoint,
+								Value: output.Value,
+								Dpt:   output.Dpt,
+								Time:  output.Time,
 								Map: model.DatapointMap{
 									{
 										Subtype:       elionaapi.SUBTYPE_INPUT,
@@ -334,7 +436,10 @@ func GetSystems(config *apiserver.Configuration) ([]model.System, error) {
 							}
 						case model.PID_COLOR_MODE_GET:
 							outputs[function_color_mode] = model.Datapoint{
-								Name: datapoint,
+								Name:  datapoint,
+								Value: output.Value,
+								Dpt:   output.Dpt,
+								Time:  output.Time,
 								Map: model.DatapointMap{
 									{
 										Subtype:       elionaapi.SUBTYPE_INPUT,
@@ -344,7 +449,10 @@ func GetSystems(config *apiserver.Configuration) ([]model.System, error) {
 							}
 						case model.PID_COLOR_TEMPERATURE_GET:
 							outputs[function_color_temperature] = model.Datapoint{
-								Name: datapoint,
+								Name:  datapoint,
+								Value: output.Value,
+								Dpt:   output.Dpt,
+								Time:  output.Time,
 								Map: model.DatapointMap{
 									{
 										Subtype:       elionaapi.SUBTYPE_OUTPUT,
@@ -395,7 +503,10 @@ func GetSystems(config *apiserver.Configuration) ([]model.System, error) {
 						switch output.PairingId {
 						case model.PID_CONTROLLER_ON_OFF_PROTECTED_GET:
 							outputs[function_switch] = model.Datapoint{
-								Name: datapoint,
+								Name:  datapoint,
+								Value: output.Value,
+								Dpt:   output.Dpt,
+								Time:  output.Time,
 								Map: model.DatapointMap{
 									{
 										Subtype:       elionaapi.SUBTYPE_OUTPUT,
@@ -405,7 +516,10 @@ func GetSystems(config *apiserver.Configuration) ([]model.System, error) {
 							}
 						case model.PID_MEASURED_TEMPERATURE:
 							outputs[function_measured_temperature] = model.Datapoint{
-								Name: datapoint,
+								Name:  datapoint,
+								Value: output.Value,
+								Dpt:   output.Dpt,
+								Time:  output.Time,
 								Map: model.DatapointMap{
 									{
 										Subtype:       elionaapi.SUBTYPE_INPUT,
@@ -415,7 +529,10 @@ func GetSystems(config *apiserver.Configuration) ([]model.System, error) {
 							}
 						case model.PID_SETPOINT_TEMPERATURE_GET:
 							outputs[function_set_temperature] = model.Datapoint{
-								Name: datapoint,
+								Name:  datapoint,
+								Value: output.Value,
+								Dpt:   output.Dpt,
+								Time:  output.Time,
 								Map: model.DatapointMap{
 									{
 										Subtype:       elionaapi.SUBTYPE_OUTPUT,
@@ -453,7 +570,10 @@ func GetSystems(config *apiserver.Configuration) ([]model.System, error) {
 						switch output.PairingId {
 						case model.PID_CONTROLLER_ON_OFF_PROTECTED_GET:
 							outputs[function_switch] = model.Datapoint{
-								Name: datapoint,
+								Name:  datapoint,
+								Value: output.Value,
+								Dpt:   output.Dpt,
+								Time:  output.Time,
 								Map: model.DatapointMap{
 									{
 										Subtype:       elionaapi.SUBTYPE_OUTPUT,
@@ -463,7 +583,10 @@ func GetSystems(config *apiserver.Configuration) ([]model.System, error) {
 							}
 						case model.PID_MEASURED_TEMPERATURE:
 							outputs[function_measured_temperature] = model.Datapoint{
-								Name: datapoint,
+								Name:  datapoint,
+								Value: output.Value,
+								Dpt:   output.Dpt,
+								Time:  output.Time,
 								Map: model.DatapointMap{
 									{
 										Subtype:       elionaapi.SUBTYPE_INPUT,
@@ -473,7 +596,10 @@ func GetSystems(config *apiserver.Configuration) ([]model.System, error) {
 							}
 						case model.PID_SETPOINT_TEMPERATURE_GET:
 							outputs[function_set_temperature] = model.Datapoint{
-								Name: datapoint,
+								Name:  datapoint,
+								Value: output.Value,
+								Dpt:   output.Dpt,
+								Time:  output.Time,
 								Map: model.DatapointMap{
 									{
 										Subtype:       elionaapi.SUBTYPE_OUTPUT,
@@ -483,7 +609,10 @@ func GetSystems(config *apiserver.Configuration) ([]model.System, error) {
 							}
 						case model.PID_HEATING_MODE_GET:
 							outputs[function_status_indication] = model.Datapoint{
-								Name: datapoint,
+								Name:  datapoint,
+								Value: output.Value,
+								Dpt:   output.Dpt,
+								Time:  output.Time,
 								Map: model.DatapointMap{
 									{
 										Subtype:       elionaapi.SUBTYPE_INPUT,
@@ -493,7 +622,10 @@ func GetSystems(config *apiserver.Configuration) ([]model.System, error) {
 							}
 						case model.PID_HEATING_ACTIVE:
 							outputs[function_heating_active] = model.Datapoint{
-								Name: datapoint,
+								Name:  datapoint,
+								Value: output.Value,
+								Dpt:   output.Dpt,
+								Time:  output.Time,
 								Map: model.DatapointMap{
 									{
 										Subtype:       elionaapi.SUBTYPE_INPUT,
@@ -503,7 +635,10 @@ func GetSystems(config *apiserver.Configuration) ([]model.System, error) {
 							}
 						case model.PID_HEATING_VALUE:
 							outputs[function_heating_value] = model.Datapoint{
-								Name: datapoint,
+								Name:  datapoint,
+								Value: output.Value,
+								Dpt:   output.Dpt,
+								Time:  output.Time,
 								Map: model.DatapointMap{
 									{
 										Subtype:       elionaapi.SUBTYPE_INPUT,
@@ -550,7 +685,10 @@ func GetSystems(config *apiserver.Configuration) ([]model.System, error) {
 					for datapoint, output := range channel.Outputs {
 						if output.PairingId == model.PID_AL_WINDOW_DOOR {
 							outputs[function_status] = model.Datapoint{
-								Name: datapoint,
+								Name:  datapoint,
+								Value: output.Value,
+								Dpt:   output.Dpt,
+								Time:  output.Time,
 								Map: model.DatapointMap{
 									{
 										Subtype:       elionaapi.SUBTYPE_INPUT,
@@ -572,7 +710,10 @@ func GetSystems(config *apiserver.Configuration) ([]model.System, error) {
 					for datapoint, output := range channel.Outputs {
 						if output.PairingId == model.PID_AL_WINDOW_DOOR_POSITION {
 							outputs[function_status] = model.Datapoint{
-								Name: datapoint,
+								Name:  datapoint,
+								Value: output.Value,
+								Dpt:   output.Dpt,
+								Time:  output.Time,
 								Map: model.DatapointMap{
 									{
 										Subtype:       elionaapi.SUBTYPE_INPUT,
@@ -594,7 +735,10 @@ func GetSystems(config *apiserver.Configuration) ([]model.System, error) {
 					for datapoint, output := range channel.Outputs {
 						if output.PairingId == model.PID_MOVEMENT_UNDER_CONSIDERATION_OF_BRIGHTNESS {
 							outputs[function_status] = model.Datapoint{
-								Name: datapoint,
+								Name:  datapoint,
+								Value: output.Value,
+								Dpt:   output.Dpt,
+								Time:  output.Time,
 								Map: model.DatapointMap{
 									{
 										Subtype:       elionaapi.SUBTYPE_INPUT,
@@ -617,7 +761,10 @@ func GetSystems(config *apiserver.Configuration) ([]model.System, error) {
 						if output.PairingId == model.PID_FIRE_ALARM_ACTIVE {
 							outputs[function_status] = model.Datapoint{
 
-								Name: datapoint,
+								Name:  datapoint,
+								Value: output.Value,
+								Dpt:   output.Dpt,
+								Time:  output.Time,
 								Map: model.DatapointMap{
 									{
 										Subtype:       elionaapi.SUBTYPE_INPUT,
@@ -639,7 +786,10 @@ func GetSystems(config *apiserver.Configuration) ([]model.System, error) {
 					for datapoint, output := range channel.Outputs {
 						if output.PairingId == model.PID_ON_OFF_INFO_GET {
 							outputs[function_floor_call] = model.Datapoint{
-								Name: datapoint,
+								Name:  datapoint,
+								Value: output.Value,
+								Dpt:   output.Dpt,
+								Time:  output.Time,
 								Map: model.DatapointMap{
 									{
 										Subtype:       elionaapi.SUBTYPE_OUTPUT,
@@ -669,7 +819,10 @@ func GetSystems(config *apiserver.Configuration) ([]model.System, error) {
 					for datapoint, output := range channel.Outputs {
 						if output.PairingId == model.PID_ON_OFF_INFO_GET {
 							outputs[function_mute_button] = model.Datapoint{
-								Name: datapoint,
+								Name:  datapoint,
+								Value: output.Value,
+								Dpt:   output.Dpt,
+								Time:  output.Time,
 								Map: model.DatapointMap{
 									{
 										Subtype:       elionaapi.SUBTYPE_OUTPUT,
@@ -694,12 +847,139 @@ func GetSystems(config *apiserver.Configuration) ([]model.System, error) {
 						AssetBase: assetBase,
 						Mute:      switchState,
 					}
+				case model.FID_DES_DOOR_OPENER_ACTUATOR:
+					outputs := make(map[string]model.Datapoint)
+					for datapoint, output := range channel.Outputs {
+						if output.PairingId == model.PID_ON_OFF_INFO_GET {
+							outputs[function_door_opener] = model.Datapoint{
+								Name:  datapoint,
+								Value: output.Value,
+								Dpt:   output.Dpt,
+								Time:  output.Time,
+								Map: model.DatapointMap{
+									{
+										Subtype:       elionaapi.SUBTYPE_OUTPUT,
+										AttributeName: "door_opener",
+									},
+								},
+							}
+						}
+					}
+					assetBase.OutputsBase = outputs
+
+					inputs := make(map[string]string)
+					for datapoint, input := range channel.Inputs {
+						if input.PairingId == model.PID_TIMED_START_STOP {
+							inputs[function_door_opener] = datapoint
+						}
+					}
+					assetBase.InputsBase = inputs
+
+					switchState := parseInt8(channel.FindOutputValueByPairingID(model.PID_ON_OFF_INFO_GET))
+					c = model.DoorOpener{
+						AssetBase:  assetBase,
+						DoorOpener: switchState,
+					}
+				case model.FID_DES_AUTOMATIC_DOOR_OPENER_ACTUATOR:
+					outputs := make(map[string]model.Datapoint)
+					for datapoint, output := range channel.Outputs {
+						if output.PairingId == model.PID_ON_OFF_INFO_GET {
+							outputs[function_automatic_door_opener] = model.Datapoint{
+								Name:  datapoint,
+								Value: output.Value,
+								Dpt:   output.Dpt,
+								Time:  output.Time,
+								Map: model.DatapointMap{
+									{
+										Subtype:       elionaapi.SUBTYPE_OUTPUT,
+										AttributeName: "automatic_door_opener",
+									},
+								},
+							}
+						}
+					}
+					assetBase.OutputsBase = outputs
+
+					inputs := make(map[string]string)
+					for datapoint, input := range channel.Inputs {
+						if input.PairingId == model.PID_SWITCH_ON_OFF_SET {
+							inputs[function_automatic_door_opener] = datapoint
+						}
+					}
+					assetBase.InputsBase = inputs
+
+					switchState := parseInt8(channel.FindOutputValueByPairingID(model.PID_ON_OFF_INFO_GET))
+					c = model.AutomaticDoorOpener{
+						AssetBase:           assetBase,
+						AutomaticDoorOpener: switchState,
+					}
+				case model.FID_DES_LIGHT_SWITCH_ACTUATOR:
+					outputs := make(map[string]model.Datapoint)
+					for datapoint, output := range channel.Outputs {
+						if output.PairingId == model.PID_ON_OFF_INFO_GET {
+							outputs[function_staircase_light] = model.Datapoint{
+								Name:  datapoint,
+								Value: output.Value,
+								Dpt:   output.Dpt,
+								Time:  output.Time,
+								Map: model.DatapointMap{
+									{
+										Subtype:       elionaapi.SUBTYPE_OUTPUT,
+										AttributeName: "staircase_light",
+									},
+								},
+							}
+						}
+					}
+					assetBase.OutputsBase = outputs
+
+					inputs := make(map[string]string)
+					for datapoint, input := range channel.Inputs {
+						if input.PairingId == model.PID_TIMED_START_STOP {
+							inputs[function_staircase_light] = datapoint
+						}
+					}
+					assetBase.InputsBase = inputs
+
+					switchState := parseInt8(channel.FindOutputValueByPairingID(model.PID_ON_OFF_INFO_GET))
+					c = model.StaircaseLight{
+						AssetBase:      assetBase,
+						StaircaseLight: switchState,
+					}
+				case model.FID_DES_DOOR_RINGING_SENSOR:
+					outputs := make(map[string]model.Datapoint)
+					for datapoint, output := range channel.Outputs {
+						if output.PairingId == model.PID_TIMED_START_STOP {
+							outputs[function_ring] = model.Datapoint{
+								Name:  datapoint,
+								Value: output.Value,
+								Dpt:   output.Dpt,
+								Time:  output.Time,
+								Map: model.DatapointMap{
+									{
+										Subtype:       elionaapi.SUBTYPE_INPUT,
+										AttributeName: "ring",
+									},
+								},
+							}
+						}
+					}
+					assetBase.OutputsBase = outputs
+
+					ring := parseInt8(channel.FindOutputValueByPairingID(model.PID_TIMED_START_STOP))
+					c = model.DoorRingingSensor{
+						AssetBase: assetBase,
+						Ring:      ring,
+					}
 				case model.FID_HEATING_ACTUATOR:
 					outputs := make(map[string]model.Datapoint)
 					for datapoint, output := range channel.Outputs {
 						if output.PairingId == model.PID_AL_INFO_VALUE_HEATING {
 							outputs[function_heating_flow] = model.Datapoint{
-								Name: datapoint,
+								Name:  datapoint,
+								Value: output.Value,
+								Dpt:   output.Dpt,
+								Time:  output.Time,
 								Map: model.DatapointMap{
 									{
 										Subtype:       elionaapi.SUBTYPE_INPUT,
@@ -710,7 +990,10 @@ func GetSystems(config *apiserver.Configuration) ([]model.System, error) {
 						}
 						if output.PairingId == model.PID_ACTUATING_VALUE_HEATING {
 							outputs[function_actuator_heating_flow] = model.Datapoint{
-								Name: datapoint,
+								Name:  datapoint,
+								Value: output.Value,
+								Dpt:   output.Dpt,
+								Time:  output.Time,
 								Map: model.DatapointMap{
 									{
 										Subtype:       elionaapi.SUBTYPE_INPUT,
@@ -737,7 +1020,10 @@ func GetSystems(config *apiserver.Configuration) ([]model.System, error) {
 					for datapoint, output := range channel.Outputs {
 						if output.PairingId == model.PID_AL_SCENE_CONTROL {
 							outputs[function_set_scene] = model.Datapoint{
-								Name: datapoint,
+								Name:  datapoint,
+								Value: output.Value,
+								Dpt:   output.Dpt,
+								Time:  output.Time,
 								Map: model.DatapointMap{
 									{
 										Subtype:       elionaapi.SUBTYPE_OUTPUT,
@@ -766,7 +1052,10 @@ func GetSystems(config *apiserver.Configuration) ([]model.System, error) {
 						switch output.PairingId {
 						case model.PID_AL_INFO_CHARGING:
 							outputs[function_switch] = model.Datapoint{
-								Name: datapoint,
+								Name:  datapoint,
+								Value: output.Value,
+								Dpt:   output.Dpt,
+								Time:  output.Time,
 								Map: model.DatapointMap{
 									{
 										Subtype:       elionaapi.SUBTYPE_OUTPUT,
@@ -776,7 +1065,10 @@ func GetSystems(config *apiserver.Configuration) ([]model.System, error) {
 							}
 						case model.PID_AL_INFO_CHARGING_ENABLED:
 							outputs[function_enable] = model.Datapoint{
-								Name: datapoint,
+								Name:  datapoint,
+								Value: output.Value,
+								Dpt:   output.Dpt,
+								Time:  output.Time,
 								Map: model.DatapointMap{
 									{
 										Subtype:       elionaapi.SUBTYPE_OUTPUT,
@@ -786,7 +1078,10 @@ func GetSystems(config *apiserver.Configuration) ([]model.System, error) {
 							}
 						case model.PID_AL_INFO_INSTALLED_POWER:
 							outputs[function_installed_power] = model.Datapoint{
-								Name: datapoint,
+								Name:  datapoint,
+								Value: output.Value,
+								Dpt:   output.Dpt,
+								Time:  output.Time,
 								Map: model.DatapointMap{
 									{
 										Subtype:       elionaapi.SUBTYPE_OUTPUT,
@@ -796,7 +1091,10 @@ func GetSystems(config *apiserver.Configuration) ([]model.System, error) {
 							}
 						case model.PID_AL_INFO_ENERGY_TRANSMITTED:
 							outputs[function_total_energy] = model.Datapoint{
-								Name: datapoint,
+								Name:  datapoint,
+								Value: output.Value,
+								Dpt:   output.Dpt,
+								Time:  output.Time,
 								Map: model.DatapointMap{
 									{
 										Subtype:       elionaapi.SUBTYPE_OUTPUT,
@@ -806,7 +1104,10 @@ func GetSystems(config *apiserver.Configuration) ([]model.System, error) {
 							}
 						case model.PID_AL_INFO_START_OF_CHARGING_SESSION:
 							outputs[function_start_last_charging] = model.Datapoint{
-								Name: datapoint,
+								Name:  datapoint,
+								Value: output.Value,
+								Dpt:   output.Dpt,
+								Time:  output.Time,
 								Map: model.DatapointMap{
 									{
 										Subtype:       elionaapi.SUBTYPE_OUTPUT,
@@ -816,7 +1117,10 @@ func GetSystems(config *apiserver.Configuration) ([]model.System, error) {
 							}
 						case model.PID_AL_INFO_WALLBOX_STATUS:
 							outputs[function_status] = model.Datapoint{
-								Name: datapoint,
+								Name:  datapoint,
+								Value: output.Value,
+								Dpt:   output.Dpt,
+								Time:  output.Time,
 								Map: model.DatapointMap{
 									{
 										Subtype:       elionaapi.SUBTYPE_OUTPUT,
@@ -871,8 +1175,25 @@ func GetSystems(config *apiserver.Configuration) ([]model.System, error) {
 					// 	AssetBase: assetBase,
 					// }
 				}
+				props := model.ChannelFilterProperties{
+					FunctionID: channel.FunctionId,
+					AssetType:  c.AssetType(),
+					Floor:      floorName,
+					FloorLevel: floorLevel,
+					Room:       roomName,
+				}
+				if adheres, err := model.AdheresToFilter(config.AssetFilter, s, d, assetBase, props); err != nil {
+					return nil, fmt.Errorf("determining whether channel adheres to a filter: %v", err)
+				} else if !adheres {
+					s.Filtered = append(s.Filtered, c.GAI())
+					continue
+				}
 				d.Channels = append(d.Channels, c)
 			}
+			if len(d.Channels) == 0 {
+				s.Filtered = append(s.Filtered, fmt.Sprintf("%s_%s", d.AssetType(), d.GAI))
+				continue // Nothing to create for the device.
+			}
 			s.Devices = append(s.Devices, d)
 		}
 		systems = append(systems, s)
@@ -929,6 +1250,31 @@ func ListenForDataChanges(config *apiserver.Configuration, datapoints []appdb.Da
 	return nil
 }
 
+// ReadDataPoints polls the current values of the output datapoints, used while the
+// subscription is unavailable.
+func ReadDataPoints(config *apiserver.Configuration, datapoints []appdb.Datapoint) ([]abbgraphql.DataPoint, error) {
+	api, err := getAPI(config)
+	if err != nil {
+		return nil, fmt.Errorf("getting API instance: %v", err)
+	}
+	var outputs []appdb.Datapoint
+	for _, dp := range datapoints {
+		if !dp.IsInput {
+			outputs = append(outputs, dp)
+		}
+	}
+	values, err := api.ReadDatapoints(outputs)
+	if err != nil && strings.Contains(err.Error(), "UNAUTHENTICATED") {
+		if _, err := conf.InvalidateAuthorization(*config); err != nil {
+			return nil, fmt.Errorf("invalidating authorization: %v", err)
+		}
+		return nil, errors.New("authorization invalidated")
+	} else if err != nil {
+		return nil, fmt.Errorf("reading datapoints: %v", err)
+	}
+	return values, nil
+}
+
 func ListenForSystemStatusChanges(config *apiserver.Configuration, dtIDs []string, ch chan<- abbgraphql.ConnectionStatus) error {
 	api, err := getAPI(config)
 	if err != nil {
@@ -0,0 +1,25 @@
+package broker
+
+import (
+	"abb-free-at-home/model"
+	"testing"
+)
+
+func TestClearHeldRings(t *testing.T) {
+	systems := []model.System{{
+		Devices: []model.Device{{
+			Channels: []model.Asset{
+				model.DoorRingingSensor{Ring: 1},
+				model.StaircaseLight{StaircaseLight: 1},
+			},
+		}},
+	}}
+	ClearHeldRings(systems)
+	channels := systems[0].Devices[0].Channels
+	if ring := channels[0].(model.DoorRingingSensor).Ring; ring != 0 {
+		t.Errorf("ring = %d, want 0", ring)
+	}
+	if light := channels[1].(model.StaircaseLight).StaircaseLight; light != 1 {
+		t.Errorf("staircase light = %d, want 1", light)
+	}
+}
@@ -18,10 +18,14 @@ package conf
 import (
 	"abb-free-at-home/apiserver"
 	"abb-free-at-home/appdb"
+	"abb-free-at-home/schedule"
 	"context"
+	"database/sql"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
 	"time"
 
 	"github.com/eliona-smart-building-assistant/go-eliona/frontend"
@@ -29,10 +33,14 @@ import (
 	"github.com/eliona-smart-building-assistant/go-utils/log"
 	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 	"golang.org/x/oauth2"
 )
 
+// Languages ABB translates the names to.
+var supportedLanguages = []string{"en", "de", "fr", "it", "nl", "es"}
+
 var ErrBadRequest = errors.New("bad request")
 
 const (
@@ -41,12 +49,36 @@ const (
 	ABB_PROSERVICE  = "ProService"
 )
 
+const (
+	ORPHAN_POLICY_KEEP   = "keep"
+	ORPHAN_POLICY_DELETE = "delete"
+)
+
+const (
+	ALERT_MODE_OFF          = "off"
+	ALERT_MODE_NOTIFICATION = "notification"
+	ALERT_MODE_ALARM        = "alarm"
+)
+
+const (
+	SYNC_MODE_DISCOVERY = "discovery"
+	SYNC_MODE_VALUES    = "values"
+	SYNC_MODE_RECONCILE = "reconcile"
+)
+
+const (
+	SYNC_STATUS_PENDING  = "pending"
+	SYNC_STATUS_RUNNING  = "running"
+	SYNC_STATUS_FINISHED = "finished"
+	SYNC_STATUS_FAILED   = "failed"
+)
+
 func InsertConfig(ctx context.Context, config apiserver.Configuration) (apiserver.Configuration, error) {
 	dbConfig, err := dbConfigFromApiConfig(ctx, config)
 	if err != nil {
-		return apiserver.Configuration{}, fmt.Errorf("creating DB config from API config: %v", err)
+		return apiserver.Configuration{}, fmt.Errorf("creating DB config from API config: %w", err)
 	}
-	if err := dbConfig.InsertG(ctx, boil.Infer()); err != nil {
+	if err := dbConfig.InsertG(ctx, configColumns(config)); err != nil {
 		log.Error("conf", "inserting config: %v", err)
 		return apiserver.Configuration{}, fmt.Errorf("inserting DB config: %v", err)
 	}
@@ -56,9 +88,10 @@ func InsertConfig(ctx context.Context, config apiserver.Configuration) (apiserve
 func UpsertConfig(ctx context.Context, config apiserver.Configuration) (apiserver.Configuration, error) {
 	dbConfig, err := dbConfigFromApiConfig(ctx, config)
 	if err != nil {
-		return apiserver.Configuration{}, fmt.Errorf("creating DB config from API config: %v", err)
+		return apiserver.Configuration{}, fmt.Errorf("creating DB config from API config: %w", err)
 	}
-	if err := dbConfig.UpsertG(ctx, true, []string{"id"}, boil.Blacklist("id"), boil.Infer()); err != nil {
+	// The scope is assigned by the app and must not change, or all assets would be created anew.
+	if err := dbConfig.UpsertG(ctx, true, []string{"id"}, boil.Blacklist("id", appdb.ConfigurationColumns.GaiScope), configColumns(config)); err != nil {
 		log.Error("conf", "upserting config %v: %v", config.Id, err)
 		return apiserver.Configuration{}, fmt.Errorf("inserting DB config: %v", err)
 	}
@@ -100,6 +133,29 @@ func DeleteConfig(ctx context.Context, configID int64) error {
 	return nil
 }
 
+// configColumns returns the columns to insert for the configuration. Inferring them leaves out
+// zero values of columns with a default, so settings where 0 has a meaning of its own are
+// always written if given.
+func configColumns(apiConfig apiserver.Configuration) boil.Columns {
+	var columns []string
+	if apiConfig.BackfillThreshold != nil {
+		columns = append(columns, appdb.ConfigurationColumns.BackfillThreshold)
+	}
+	if apiConfig.OfflineNotificationThreshold != nil {
+		columns = append(columns, appdb.ConfigurationColumns.OfflineNotificationThreshold)
+	}
+	if apiConfig.LowBatteryThreshold != nil {
+		columns = append(columns, appdb.ConfigurationColumns.LowBatteryThreshold)
+	}
+	if apiConfig.WeakSignalThreshold != nil {
+		columns = append(columns, appdb.ConfigurationColumns.WeakSignalThreshold)
+	}
+	if apiConfig.AlertHysteresis != nil {
+		columns = append(columns, appdb.ConfigurationColumns.AlertHysteresis)
+	}
+	return boil.Greylist(columns...)
+}
+
 func dbConfigFromApiConfig(ctx context.Context, apiConfig apiserver.Configuration) (dbConfig appdb.Configuration, err error) {
 	switch apiConfig.AbbConnectionType {
 	case ABB_LOCAL:
@@ -152,10 +208,108 @@ func dbConfigFromApiConfig(ctx context.Context, apiConfig apiserver.Configuratio
 
 	dbConfig.ID = null.Int64FromPtr(apiConfig.Id).Int64
 	dbConfig.Enable = null.BoolFromPtr(apiConfig.Enable)
+	if apiConfig.RefreshInterval < 1 {
+		return appdb.Configuration{}, fmt.Errorf("%w: refreshInterval must be at least 1", ErrBadRequest)
+	}
 	dbConfig.RefreshInterval = apiConfig.RefreshInterval
+	if apiConfig.RefreshCron != nil {
+		if *apiConfig.RefreshCron != "" {
+			if _, err := schedule.ParseCron(*apiConfig.RefreshCron); err != nil {
+				return appdb.Configuration{}, fmt.Errorf("%w: %v", ErrBadRequest, err)
+			}
+		}
+		dbConfig.RefreshCron = *apiConfig.RefreshCron
+	}
+	if apiConfig.DiscoveryInterval != nil {
+		if *apiConfig.DiscoveryInterval < 1 {
+			return appdb.Configuration{}, fmt.Errorf("%w: discoveryInterval must be at least 1", ErrBadRequest)
+		}
+		dbConfig.DiscoveryInterval = *apiConfig.DiscoveryInterval
+	}
+	if apiConfig.DiscoveryCron != nil {
+		if *apiConfig.DiscoveryCron != "" {
+			if _, err := schedule.ParseCron(*apiConfig.DiscoveryCron); err != nil {
+				return appdb.Configuration{}, fmt.Errorf("%w: %v", ErrBadRequest, err)
+			}
+		}
+		dbConfig.DiscoveryCron = *apiConfig.DiscoveryCron
+	}
+	if apiConfig.DiscoveryQuietHours != nil {
+		if *apiConfig.DiscoveryQuietHours != "" {
+			if _, err := schedule.ParseQuietHours(*apiConfig.DiscoveryQuietHours); err != nil {
+				return appdb.Configuration{}, fmt.Errorf("%w: %v", ErrBadRequest, err)
+			}
+		}
+		dbConfig.DiscoveryQuietHours = *apiConfig.DiscoveryQuietHours
+	}
 	if apiConfig.RequestTimeout != nil {
 		dbConfig.RequestTimeout = *apiConfig.RequestTimeout
 	}
+	if apiConfig.BackfillThreshold != nil {
+		dbConfig.BackfillThreshold = *apiConfig.BackfillThreshold
+	}
+	if apiConfig.BufferSize != nil {
+		if *apiConfig.BufferSize < 1 {
+			return appdb.Configuration{}, fmt.Errorf("%w: bufferSize must be at least 1", ErrBadRequest)
+		}
+		dbConfig.BufferSize = *apiConfig.BufferSize
+	}
+	if apiConfig.BufferCompaction != nil {
+		dbConfig.BufferCompaction = *apiConfig.BufferCompaction
+	}
+	if apiConfig.OrphanGracePeriod != nil {
+		dbConfig.OrphanGracePeriod = *apiConfig.OrphanGracePeriod
+	}
+	if apiConfig.OrphanPolicy != nil {
+		if *apiConfig.OrphanPolicy != ORPHAN_POLICY_KEEP && *apiConfig.OrphanPolicy != ORPHAN_POLICY_DELETE {
+			return appdb.Configuration{}, fmt.Errorf("%w: unknown orphan policy '%s'", ErrBadRequest, *apiConfig.OrphanPolicy)
+		}
+		dbConfig.OrphanPolicy = *apiConfig.OrphanPolicy
+	}
+	if apiConfig.RootAssetName != nil {
+		dbConfig.RootAssetName = *apiConfig.RootAssetName
+	}
+	if apiConfig.LowBatteryThreshold != nil {
+		dbConfig.LowBatteryThreshold = *apiConfig.LowBatteryThreshold
+	}
+	if apiConfig.WeakSignalThreshold != nil {
+		dbConfig.WeakSignalThreshold = *apiConfig.WeakSignalThreshold
+	}
+	if apiConfig.AlertHysteresis != nil {
+		dbConfig.AlertHysteresis = *apiConfig.AlertHysteresis
+	}
+	if apiConfig.AlertMode != nil {
+		switch *apiConfig.AlertMode {
+		case ALERT_MODE_OFF, ALERT_MODE_NOTIFICATION, ALERT_MODE_ALARM:
+		default:
+			return appdb.Configuration{}, fmt.Errorf("%w: unknown alert mode '%s'", ErrBadRequest, *apiConfig.AlertMode)
+		}
+		dbConfig.AlertMode = *apiConfig.AlertMode
+	}
+	if apiConfig.OfflineNotificationThreshold != nil {
+		dbConfig.OfflineNotificationThreshold = *apiConfig.OfflineNotificationThreshold
+	}
+	if apiConfig.RingNotifications != nil {
+		dbConfig.RingNotifications = *apiConfig.RingNotifications
+	}
+	if apiConfig.Language != nil {
+		if !slices.Contains(supportedLanguages, *apiConfig.Language) {
+			return appdb.Configuration{}, fmt.Errorf("%w: unsupported language '%s'", ErrBadRequest, *apiConfig.Language)
+		}
+		dbConfig.Language = *apiConfig.Language
+	}
+	if apiConfig.DeviceNameTemplate != nil {
+		dbConfig.DeviceNameTemplate = *apiConfig.DeviceNameTemplate
+	}
+	if apiConfig.ChannelNameTemplate != nil {
+		dbConfig.ChannelNameTemplate = *apiConfig.ChannelNameTemplate
+	}
+	if apiConfig.DeviceDescriptionTemplate != nil {
+		dbConfig.DeviceDescriptionTemplate = *apiConfig.DeviceDescriptionTemplate
+	}
+	if apiConfig.ChannelDescriptionTemplate != nil {
+		dbConfig.ChannelDescriptionTemplate = *apiConfig.ChannelDescriptionTemplate
+	}
 	af, err := json.Marshal(apiConfig.AssetFilter)
 	if err != nil {
 		return appdb.Configuration{}, fmt.Errorf("marshalling assetFilter: %v", err)
@@ -165,6 +319,13 @@ func dbConfigFromApiConfig(ctx context.Context, apiConfig apiserver.Configuratio
 	if apiConfig.ProjectIDs != nil {
 		dbConfig.ProjectIds = *apiConfig.ProjectIDs
 	}
+	if apiConfig.SystemProjectIDs != nil {
+		spi, err := json.Marshal(*apiConfig.SystemProjectIDs)
+		if err != nil {
+			return appdb.Configuration{}, fmt.Errorf("marshalling systemProjectIDs: %v", err)
+		}
+		dbConfig.SystemProjectIds = null.JSONFrom(spi)
+	}
 
 	env := frontend.GetEnvironment(ctx)
 	if env != nil {
@@ -199,7 +360,29 @@ func apiConfigFromDbConfig(dbConfig *appdb.Configuration) (apiConfig apiserver.C
 	apiConfig.Id = &dbConfig.ID
 	apiConfig.Enable = dbConfig.Enable.Ptr()
 	apiConfig.RefreshInterval = dbConfig.RefreshInterval
+	apiConfig.RefreshCron = &dbConfig.RefreshCron
+	apiConfig.DiscoveryInterval = &dbConfig.DiscoveryInterval
+	apiConfig.DiscoveryCron = &dbConfig.DiscoveryCron
+	apiConfig.DiscoveryQuietHours = &dbConfig.DiscoveryQuietHours
 	apiConfig.RequestTimeout = &dbConfig.RequestTimeout
+	apiConfig.BackfillThreshold = &dbConfig.BackfillThreshold
+	apiConfig.BufferSize = &dbConfig.BufferSize
+	apiConfig.BufferCompaction = &dbConfig.BufferCompaction
+	apiConfig.OrphanGracePeriod = &dbConfig.OrphanGracePeriod
+	apiConfig.OrphanPolicy = &dbConfig.OrphanPolicy
+	apiConfig.RootAssetName = &dbConfig.RootAssetName
+	apiConfig.LowBatteryThreshold = &dbConfig.LowBatteryThreshold
+	apiConfig.WeakSignalThreshold = &dbConfig.WeakSignalThreshold
+	apiConfig.AlertHysteresis = &dbConfig.AlertHysteresis
+	apiConfig.AlertMode = &dbConfig.AlertMode
+	apiConfig.OfflineNotificationThreshold = &dbConfig.OfflineNotificationThreshold
+	apiConfig.RingNotifications = &dbConfig.RingNotifications
+	apiConfig.Language = &dbConfig.Language
+	apiConfig.DeviceNameTemplate = &dbConfig.DeviceNameTemplate
+	apiConfig.ChannelNameTemplate = &dbConfig.ChannelNameTemplate
+	apiConfig.DeviceDescriptionTemplate = &dbConfig.DeviceDescriptionTemplate
+	apiConfig.ChannelDescriptionTemplate = &dbConfig.ChannelDescriptionTemplate
+	apiConfig.GaiScope = common.Ptr(gaiScope(dbConfig))
 	if dbConfig.AssetFilter.Valid {
 		var af [][]apiserver.FilterRule
 		if err := json.Unmarshal(dbConfig.AssetFilter.JSON, &af); err != nil {
@@ -209,10 +392,33 @@ func apiConfigFromDbConfig(dbConfig *appdb.Configuration) (apiConfig apiserver.C
 	}
 	apiConfig.Active = dbConfig.Active.Ptr()
 	apiConfig.ProjectIDs = common.Ptr[[]string](dbConfig.ProjectIds)
+	if dbConfig.SystemProjectIds.Valid {
+		var spi map[string][]string
+		if err := json.Unmarshal(dbConfig.SystemProjectIds.JSON, &spi); err != nil {
+			return apiserver.Configuration{}, fmt.Errorf("unmarshalling systemProjectIDs: %v", err)
+		}
+		apiConfig.SystemProjectIDs = &spi
+	}
 	apiConfig.UserId = dbConfig.UserID.Ptr()
 	return apiConfig, nil
 }
 
+func gaiScope(dbConfig *appdb.Configuration) string {
+	if dbConfig.GaiScope.Valid {
+		return dbConfig.GaiScope.String
+	}
+	return fmt.Sprintf("cfg%d", dbConfig.ID)
+}
+
+// ElionaGAI returns the global asset identifier used in Eliona for the app's identifier of an
+// asset. The app itself keeps the unscoped identifiers, as it stores them per configuration.
+func ElionaGAI(config apiserver.Configuration, gai string) string {
+	if config.GaiScope == nil || *config.GaiScope == "" {
+		return gai
+	}
+	return fmt.Sprintf("%s_%s", *config.GaiScope, gai)
+}
+
 func GetConfigs(ctx context.Context) ([]apiserver.Configuration, error) {
 	dbConfigs, err := appdb.Configurations().AllG(ctx)
 	if err != nil {
@@ -244,6 +450,39 @@ func ProjIds(config apiserver.Configuration) []string {
 	return *config.ProjectIDs
 }
 
+// SystemProjIds returns the projects the system is created in. Systems without
+// their own mapping use the configuration's projects.
+func SystemProjIds(config apiserver.Configuration, systemID string) []string {
+	if config.SystemProjectIDs != nil {
+		if projIds, ok := (*config.SystemProjectIDs)[systemID]; ok {
+			return projIds
+		}
+	}
+	return ProjIds(config)
+}
+
+// IsSystemInProject tells whether the system is created in the project.
+func IsSystemInProject(config apiserver.Configuration, systemID, projId string) bool {
+	return slices.Contains(SystemProjIds(config, systemID), projId)
+}
+
+// AllProjIds returns the configuration's projects together with all projects systems are mapped to.
+func AllProjIds(config apiserver.Configuration) []string {
+	projIds := slices.Clone(ProjIds(config))
+	if config.SystemProjectIDs == nil {
+		return projIds
+	}
+	systemIDs := slices.Sorted(maps.Keys(*config.SystemProjectIDs))
+	for _, systemID := range systemIDs {
+		for _, projId := range (*config.SystemProjectIDs)[systemID] {
+			if !slices.Contains(projIds, projId) {
+				projIds = append(projIds, projId)
+			}
+		}
+	}
+	return projIds
+}
+
 func IsConfigActive(config apiserver.Configuration) bool {
 	return config.Active == nil || *config.Active
 }
@@ -281,17 +520,33 @@ func InvalidateAuthorization(config apiserver.Configuration) (int64, error) {
 	})
 }
 
-func UpsertAsset(ctx context.Context, config apiserver.Configuration, projId, globalAssetID, assetTypeName, providerID string, assetId int32) error {
+// UpsertAsset stores the asset mapping. As the entity is reported by ABB, any orphan marks are cleared.
+func UpsertAsset(ctx context.Context, config apiserver.Configuration, projId, globalAssetID, assetTypeName, providerID, name string, locationalParentID *int32, assetId int32) error {
 	var dbAsset appdb.Asset
 	dbAsset.ConfigurationID = null.Int64FromPtr(config.Id).Int64
 	dbAsset.ProjectID = projId
 	dbAsset.GlobalAssetID = globalAssetID
 	dbAsset.AssetTypeName = assetTypeName
 	dbAsset.ProviderID = providerID
+	dbAsset.Name = null.StringFrom(name)
+	dbAsset.LocationalParentID = null.Int32FromPtr(locationalParentID)
 	dbAsset.AssetID = null.Int32From(assetId)
 	return dbAsset.UpsertG(ctx, true, []string{"asset_id"}, boil.Blacklist("asset_id"), boil.Infer())
 }
 
+// GetAsset returns the stored asset mapping, or nil if there is none.
+func GetAsset(ctx context.Context, config apiserver.Configuration, projId string, globalAssetID string) (*appdb.Asset, error) {
+	dbAsset, err := appdb.Assets(
+		appdb.AssetWhere.ConfigurationID.EQ(null.Int64FromPtr(config.Id).Int64),
+		appdb.AssetWhere.ProjectID.EQ(projId),
+		appdb.AssetWhere.GlobalAssetID.EQ(globalAssetID),
+	).OneG(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return dbAsset, err
+}
+
 func GetAssetId(ctx context.Context, config apiserver.Configuration, projId string, globalAssetID string) (*int32, error) {
 	dbAsset, err := appdb.Assets(
 		appdb.AssetWhere.ConfigurationID.EQ(null.Int64FromPtr(config.Id).Int64),
@@ -304,6 +559,48 @@ func GetAssetId(ctx context.Context, config apiserver.Configuration, projId stri
 	return common.Ptr(dbAsset[0].AssetID.Int32), nil
 }
 
+// GetAssetIDsOfSameConfig returns the Eliona asset IDs of all assets belonging to the same configuration
+// and project as the given Eliona asset. Retired assets are left out.
+func GetAssetIDsOfSameConfig(ctx context.Context, assetID int32) (map[int32]bool, error) {
+	dbAsset, err := appdb.Assets(
+		appdb.AssetWhere.AssetID.EQ(null.Int32From(assetID)),
+	).OneG(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("finding asset %d: %w", assetID, err)
+	}
+	dbAssets, err := appdb.Assets(
+		appdb.AssetWhere.ConfigurationID.EQ(dbAsset.ConfigurationID),
+		appdb.AssetWhere.ProjectID.EQ(dbAsset.ProjectID),
+		appdb.AssetWhere.RetiredAt.IsNull(),
+	).AllG(ctx)
+	if err != nil {
+		return nil, err
+	}
+	assetIDs := make(map[int32]bool, len(dbAssets))
+	for _, a := range dbAssets {
+		if a.AssetID.Valid {
+			assetIDs[a.AssetID.Int32] = true
+		}
+	}
+	return assetIDs, nil
+}
+
+// GetAssetsByGAI returns all assets of the configuration in the project, keyed by their GAI.
+func GetAssetsByGAI(ctx context.Context, config apiserver.Configuration, projId string) (map[string]*appdb.Asset, error) {
+	dbAssets, err := appdb.Assets(
+		appdb.AssetWhere.ConfigurationID.EQ(null.Int64FromPtr(config.Id).Int64),
+		appdb.AssetWhere.ProjectID.EQ(projId),
+	).AllG(ctx)
+	if err != nil {
+		return nil, err
+	}
+	assets := make(map[string]*appdb.Asset, len(dbAssets))
+	for _, a := range dbAssets {
+		assets[a.GlobalAssetID] = a
+	}
+	return assets, nil
+}
+
 func FindAssetByProviderID(ctx context.Context, config apiserver.Configuration, providerID string) (*appdb.Asset, error) {
 	dbAsset, err := appdb.Assets(
 		appdb.AssetWhere.ConfigurationID.EQ(null.Int64FromPtr(config.Id).Int64),
@@ -326,7 +623,7 @@ func GetSystems(ctx context.Context, config apiserver.Configuration) ([]*appdb.A
 	return dbAssets, nil
 }
 
-func InsertOutput(assetId int32, systemId, deviceId, channelId, datapoint, function string) (int64, error) {
+func InsertOutput(assetId int32, systemId, deviceId, channelId, datapoint, dpt, function string) (int64, error) {
 	output := appdb.Datapoint{
 		AssetID:   assetId,
 		SystemID:  systemId,
@@ -335,6 +632,7 @@ func InsertOutput(assetId int32, systemId, deviceId, channelId, datapoint, funct
 		Datapoint: datapoint,
 		Function:  function,
 		IsInput:   false,
+		DPT:       null.NewString(dpt, dpt != ""),
 	}
 	err := output.InsertG(context.Background(), boil.Infer())
 	return output.ID, err
@@ -366,6 +664,18 @@ func FetchInput(assetId int32, function string) (appdb.Datapoint, error) {
 	return *input, nil
 }
 
+func FetchOutput(assetId int32, function string) (appdb.Datapoint, error) {
+	output, err := appdb.Datapoints(
+		appdb.DatapointWhere.IsInput.EQ(false),
+		appdb.DatapointWhere.AssetID.EQ(assetId),
+		appdb.DatapointWhere.Function.EQ(function),
+	).OneG(context.Background())
+	if err != nil {
+		return appdb.Datapoint{}, err
+	}
+	return *output, nil
+}
+
 func LastWriteToAsset(assetId int32) (time.Time, error) {
 	input, err := appdb.Datapoints(
 		appdb.DatapointWhere.IsInput.EQ(true),
@@ -381,8 +691,13 @@ func LastWriteToAsset(assetId int32) (time.Time, error) {
 	return input.LastWrittenTime.Time, nil
 }
 
-func FetchAllDatapoints() ([]appdb.Datapoint, error) {
-	datapoints, err := appdb.Datapoints().AllG(context.Background())
+// FetchAllDatapoints returns all datapoints of the configuration except those of retired assets.
+func FetchAllDatapoints(config apiserver.Configuration) ([]appdb.Datapoint, error) {
+	datapoints, err := appdb.Datapoints(
+		qm.InnerJoin(`"abb_free_at_home"."asset" on "abb_free_at_home"."asset"."asset_id" = "abb_free_at_home"."datapoint"."asset_id"`),
+		appdb.AssetWhere.ConfigurationID.EQ(null.Int64FromPtr(config.Id).Int64),
+		appdb.AssetWhere.RetiredAt.IsNull(),
+	).AllG(context.Background())
 	if err != nil {
 		return nil, err
 	}
@@ -395,6 +710,34 @@ func FetchAllDatapoints() ([]appdb.Datapoint, error) {
 	return valueSlice, nil
 }
 
+// GetDatapointsByAsset returns all datapoints of the configuration with their attribute links loaded, keyed by Eliona asset ID.
+func GetDatapointsByAsset(ctx context.Context, config apiserver.Configuration) (map[int32][]*appdb.Datapoint, error) {
+	datapoints, err := appdb.Datapoints(
+		qm.InnerJoin(`"abb_free_at_home"."asset" on "abb_free_at_home"."asset"."asset_id" = "abb_free_at_home"."datapoint"."asset_id"`),
+		appdb.AssetWhere.ConfigurationID.EQ(null.Int64FromPtr(config.Id).Int64),
+		qm.Load(appdb.DatapointRels.DatapointAttributes),
+	).AllG(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byAsset := make(map[int32][]*appdb.Datapoint)
+	for _, dp := range datapoints {
+		byAsset[dp.AssetID] = append(byAsset[dp.AssetID], dp)
+	}
+	return byAsset, nil
+}
+
+// DeleteDatapoint removes the datapoint together with its attribute links.
+func DeleteDatapoint(ctx context.Context, datapoint *appdb.Datapoint) error {
+	_, err := datapoint.DeleteG(ctx)
+	return err
+}
+
+func UnlinkDatapointAttribute(ctx context.Context, attr *appdb.DatapointAttribute) error {
+	_, err := attr.DeleteG(ctx)
+	return err
+}
+
 func UpdateDatapoint(datapoint appdb.Datapoint) error {
 	_, err := datapoint.UpdateG(context.Background(), boil.Infer())
 	return err
@@ -435,3 +778,692 @@ func LinkDatapointToAttribute(datapointId int64, subtype, attributeName string)
 	}
 	return attr.InsertG(context.Background(), boil.Infer())
 }
+
+// AttributeKey identifies the attribute of an Eliona asset a datapoint is linked to.
+type AttributeKey struct {
+	AssetID   int32
+	Subtype   string
+	Attribute string
+}
+
+// GetAttributeTransformations returns the transformation rules of all attribute links of the
+// configuration that have any.
+func GetAttributeTransformations(ctx context.Context, config apiserver.Configuration) (map[AttributeKey][]apiserver.TransformationRule, error) {
+	attrs, err := appdb.DatapointAttributes(
+		qm.InnerJoin(`"abb_free_at_home"."datapoint" on "abb_free_at_home"."datapoint"."id" = "abb_free_at_home"."datapoint_attribute"."datapoint_id"`),
+		qm.InnerJoin(`"abb_free_at_home"."asset" on "abb_free_at_home"."asset"."asset_id" = "abb_free_at_home"."datapoint"."asset_id"`),
+		appdb.AssetWhere.ConfigurationID.EQ(null.Int64FromPtr(config.Id).Int64),
+		appdb.DatapointAttributeWhere.Transformation.IsNotNull(),
+		qm.Load(appdb.DatapointAttributeRels.Datapoint),
+	).AllG(ctx)
+	if err != nil {
+		return nil, err
+	}
+	transformations := make(map[AttributeKey][]apiserver.TransformationRule, len(attrs))
+	for _, attr := range attrs {
+		rules, err := TransformationRules(attr)
+		if err != nil {
+			return nil, err
+		}
+		transformations[AttributeKey{attr.R.Datapoint.AssetID, attr.Subtype, attr.AttributeName}] = rules
+	}
+	return transformations, nil
+}
+
+// GetAttributeTransformation returns the transformation rules of the attribute, or nil if it has none.
+func GetAttributeTransformation(ctx context.Context, key AttributeKey) ([]apiserver.TransformationRule, error) {
+	attr, err := appdb.DatapointAttributes(
+		qm.InnerJoin(`"abb_free_at_home"."datapoint" on "abb_free_at_home"."datapoint"."id" = "abb_free_at_home"."datapoint_attribute"."datapoint_id"`),
+		appdb.DatapointWhere.AssetID.EQ(key.AssetID),
+		appdb.DatapointAttributeWhere.Subtype.EQ(key.Subtype),
+		appdb.DatapointAttributeWhere.AttributeName.EQ(key.Attribute),
+		appdb.DatapointAttributeWhere.Transformation.IsNotNull(),
+	).OneG(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return TransformationRules(attr)
+}
+
+// GetAttributeTransformationsByConfig lists the attribute links of the configuration that have
+// transformation rules.
+func GetAttributeTransformationsByConfig(ctx context.Context, config apiserver.Configuration) ([]apiserver.AttributeTransformation, error) {
+	attrs, err := appdb.DatapointAttributes(
+		qm.InnerJoin(`"abb_free_at_home"."datapoint" on "abb_free_at_home"."datapoint"."id" = "abb_free_at_home"."datapoint_attribute"."datapoint_id"`),
+		qm.InnerJoin(`"abb_free_at_home"."asset" on "abb_free_at_home"."asset"."asset_id" = "abb_free_at_home"."datapoint"."asset_id"`),
+		appdb.AssetWhere.ConfigurationID.EQ(null.Int64FromPtr(config.Id).Int64),
+		appdb.DatapointAttributeWhere.Transformation.IsNotNull(),
+		qm.Load(qm.Rels(appdb.DatapointAttributeRels.Datapoint, appdb.DatapointRels.Asset)),
+		qm.OrderBy(`"abb_free_at_home"."datapoint_attribute"."id"`),
+	).AllG(ctx)
+	if err != nil {
+		return nil, err
+	}
+	transformations := []apiserver.AttributeTransformation{}
+	for _, attr := range attrs {
+		rules, err := TransformationRules(attr)
+		if err != nil {
+			return nil, err
+		}
+		transformations = append(transformations, apiserver.AttributeTransformation{
+			AssetId:   attr.R.Datapoint.AssetID,
+			Gai:       attr.R.Datapoint.R.Asset.GlobalAssetID,
+			Subtype:   attr.Subtype,
+			Attribute: attr.AttributeName,
+			Datapoint: attr.R.Datapoint.Datapoint,
+			Rules:     rules,
+		})
+	}
+	return transformations, nil
+}
+
+// SetAttributeTransformation stores the rules for the links of the asset's attribute. Empty rules
+// remove the transformation. It fails with ErrBadRequest if the attribute is not linked to any
+// datapoint of the configuration.
+func SetAttributeTransformation(ctx context.Context, config apiserver.Configuration, transformation apiserver.AttributeTransformation) (apiserver.AttributeTransformation, error) {
+	attrs, err := appdb.DatapointAttributes(
+		qm.InnerJoin(`"abb_free_at_home"."datapoint" on "abb_free_at_home"."datapoint"."id" = "abb_free_at_home"."datapoint_attribute"."datapoint_id"`),
+		qm.InnerJoin(`"abb_free_at_home"."asset" on "abb_free_at_home"."asset"."asset_id" = "abb_free_at_home"."datapoint"."asset_id"`),
+		appdb.AssetWhere.ConfigurationID.EQ(null.Int64FromPtr(config.Id).Int64),
+		appdb.DatapointWhere.AssetID.EQ(transformation.AssetId),
+		appdb.DatapointAttributeWhere.Subtype.EQ(transformation.Subtype),
+		appdb.DatapointAttributeWhere.AttributeName.EQ(transformation.Attribute),
+		qm.Load(qm.Rels(appdb.DatapointAttributeRels.Datapoint, appdb.DatapointRels.Asset)),
+	).AllG(ctx)
+	if err != nil {
+		return apiserver.AttributeTransformation{}, err
+	}
+	if len(attrs) == 0 {
+		return apiserver.AttributeTransformation{}, fmt.Errorf("%w: attribute %s/%s of asset %d is not linked to a datapoint", ErrBadRequest, transformation.Subtype, transformation.Attribute, transformation.AssetId)
+	}
+	value := null.JSON{}
+	if len(transformation.Rules) > 0 {
+		rules, err := json.Marshal(transformation.Rules)
+		if err != nil {
+			return apiserver.AttributeTransformation{}, fmt.Errorf("marshalling rules: %v", err)
+		}
+		value = null.JSONFrom(rules)
+	}
+	if _, err := attrs.UpdateAllG(ctx, appdb.M{appdb.DatapointAttributeColumns.Transformation: value}); err != nil {
+		return apiserver.AttributeTransformation{}, fmt.Errorf("updating attribute links: %v", err)
+	}
+	transformation.Gai = attrs[0].R.Datapoint.R.Asset.GlobalAssetID
+	transformation.Datapoint = attrs[0].R.Datapoint.Datapoint
+	if transformation.Rules == nil {
+		transformation.Rules = []apiserver.TransformationRule{}
+	}
+	return transformation, nil
+}
+
+// TransformationRules returns the transformation rules stored with the attribute link, or nil if
+// it has none.
+func TransformationRules(attr *appdb.DatapointAttribute) ([]apiserver.TransformationRule, error) {
+	if !attr.Transformation.Valid {
+		return nil, nil
+	}
+	var rules []apiserver.TransformationRule
+	if err := json.Unmarshal(attr.Transformation.JSON, &rules); err != nil {
+		return nil, fmt.Errorf("unmarshalling transformation of attribute link %d: %v", attr.ID, err)
+	}
+	return rules, nil
+}
+
+// GetOutputDatapoints returns all output datapoints of the configuration with their backfill watermarks loaded.
+func GetOutputDatapoints(ctx context.Context, config apiserver.Configuration) ([]*appdb.Datapoint, error) {
+	return appdb.Datapoints(
+		qm.InnerJoin(`"abb_free_at_home"."asset" on "abb_free_at_home"."asset"."asset_id" = "abb_free_at_home"."datapoint"."asset_id"`),
+		appdb.AssetWhere.ConfigurationID.EQ(null.Int64FromPtr(config.Id).Int64),
+		appdb.DatapointWhere.IsInput.EQ(false),
+		qm.Load(appdb.DatapointRels.DatapointWatermark),
+	).AllG(ctx)
+}
+
+func SetDatapointWatermark(ctx context.Context, datapointId int64, valueTime time.Time) error {
+	watermark := appdb.DatapointWatermark{
+		DatapointID: datapointId,
+		ValueTime:   valueTime,
+	}
+	return watermark.UpsertG(ctx, true, []string{appdb.DatapointWatermarkColumns.DatapointID}, boil.Whitelist(appdb.DatapointWatermarkColumns.ValueTime), boil.Infer())
+}
+
+// GetSyncWatermark returns the last time the configuration's data was synchronized to Eliona, or nil if never.
+func GetSyncWatermark(ctx context.Context, config apiserver.Configuration) (*time.Time, error) {
+	watermark, err := appdb.FindSyncWatermarkG(ctx, null.Int64FromPtr(config.Id).Int64)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return &watermark.SyncedAt, nil
+}
+
+func SetSyncWatermark(ctx context.Context, config apiserver.Configuration, syncedAt time.Time) error {
+	watermark := appdb.SyncWatermark{
+		ConfigurationID: null.Int64FromPtr(config.Id).Int64,
+		SyncedAt:        syncedAt,
+	}
+	return watermark.UpsertG(ctx, true, []string{appdb.SyncWatermarkColumns.ConfigurationID}, boil.Whitelist(appdb.SyncWatermarkColumns.SyncedAt), boil.Infer())
+}
+
+func BufferedDataExists(ctx context.Context, config apiserver.Configuration) (bool, error) {
+	return appdb.BufferedData(
+		appdb.BufferedDatumWhere.ConfigurationID.EQ(null.Int64FromPtr(config.Id).Int64),
+	).ExistsG(ctx)
+}
+
+// BufferData stores an update that could not be delivered to Eliona. With compaction
+// enabled, previously buffered values of the same asset attribute are replaced. Returns
+// the number of oldest updates dropped to keep the buffer within its size.
+func BufferData(ctx context.Context, config apiserver.Configuration, datum appdb.BufferedDatum) (int64, error) {
+	configID := null.Int64FromPtr(config.Id).Int64
+	datum.ConfigurationID = configID
+	if config.BufferCompaction != nil && *config.BufferCompaction {
+		if _, err := appdb.BufferedData(
+			appdb.BufferedDatumWhere.ConfigurationID.EQ(configID),
+			appdb.BufferedDatumWhere.AssetID.EQ(datum.AssetID),
+			appdb.BufferedDatumWhere.Subtype.EQ(datum.Subtype),
+			appdb.BufferedDatumWhere.AttributeName.EQ(datum.AttributeName),
+		).DeleteAllG(ctx); err != nil {
+			return 0, fmt.Errorf("compacting buffered data: %v", err)
+		}
+	}
+	if err := datum.InsertG(ctx, boil.Infer()); err != nil {
+		return 0, fmt.Errorf("inserting buffered data: %v", err)
+	}
+	if config.BufferSize == nil {
+		return 0, nil
+	}
+	overflow, err := appdb.BufferedData(
+		qm.Select(appdb.BufferedDatumColumns.ID),
+		appdb.BufferedDatumWhere.ConfigurationID.EQ(configID),
+		qm.OrderBy(appdb.BufferedDatumColumns.ID+" DESC"),
+		qm.Offset(int(*config.BufferSize)),
+	).AllG(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("fetching buffer overflow: %v", err)
+	}
+	if len(overflow) == 0 {
+		return 0, nil
+	}
+	ids := make([]int64, len(overflow))
+	for i, d := range overflow {
+		ids[i] = d.ID
+	}
+	return appdb.BufferedData(appdb.BufferedDatumWhere.ID.IN(ids)).DeleteAllG(ctx)
+}
+
+// GetBufferedData returns the oldest buffered updates of the configuration in the order they were buffered.
+func GetBufferedData(ctx context.Context, config apiserver.Configuration, limit int) ([]*appdb.BufferedDatum, error) {
+	return appdb.BufferedData(
+		appdb.BufferedDatumWhere.ConfigurationID.EQ(null.Int64FromPtr(config.Id).Int64),
+		qm.OrderBy(appdb.BufferedDatumColumns.ID),
+		qm.Limit(limit),
+	).AllG(ctx)
+}
+
+func DeleteBufferedData(ctx context.Context, ids []int64) error {
+	_, err := appdb.BufferedData(appdb.BufferedDatumWhere.ID.IN(ids)).DeleteAllG(ctx)
+	return err
+}
+
+func GetBufferStatus(ctx context.Context, configID int64) (apiserver.BufferStatus, error) {
+	var status struct {
+		Depth           int64     `boil:"depth"`
+		Assets          int64     `boil:"assets"`
+		OldestValueTime null.Time `boil:"oldest_value_time"`
+	}
+	err := queries.Raw(`
+		select count(*) as depth, count(distinct asset_id) as assets, min(value_time) as oldest_value_time
+		from abb_free_at_home.buffered_data
+		where configuration_id = $1`, configID,
+	).BindG(ctx, &status)
+	if err != nil {
+		return apiserver.BufferStatus{}, err
+	}
+	return apiserver.BufferStatus{
+		ConfigId:        configID,
+		Depth:           status.Depth,
+		Assets:          status.Assets,
+		OldestValueTime: status.OldestValueTime.Ptr(),
+	}, nil
+}
+
+// MarkAssetOrphaned remembers since when the asset's entity is missing at ABB.
+func MarkAssetOrphaned(ctx context.Context, asset *appdb.Asset, since time.Time) error {
+	asset.OrphanedAt = null.TimeFrom(since)
+	_, err := asset.UpdateG(ctx, boil.Whitelist(appdb.AssetColumns.OrphanedAt))
+	return err
+}
+
+func MarkAssetRetired(ctx context.Context, asset *appdb.Asset, at time.Time) error {
+	asset.RetiredAt = null.TimeFrom(at)
+	_, err := asset.UpdateG(ctx, boil.Whitelist(appdb.AssetColumns.RetiredAt))
+	return err
+}
+
+func ClearAssetOrphaned(ctx context.Context, asset *appdb.Asset) error {
+	asset.OrphanedAt = null.Time{}
+	asset.RetiredAt = null.Time{}
+	_, err := asset.UpdateG(ctx, boil.Whitelist(appdb.AssetColumns.OrphanedAt, appdb.AssetColumns.RetiredAt))
+	return err
+}
+
+// DeleteAsset removes the asset mapping together with its datapoints.
+func DeleteAsset(ctx context.Context, asset *appdb.Asset) error {
+	_, err := asset.DeleteG(ctx)
+	return err
+}
+
+// GetLinkedAssets returns the assets of the configuration linked to an Eliona asset, except the
+// forgotten ones, with their datapoints loaded.
+func GetLinkedAssets(ctx context.Context, config apiserver.Configuration) ([]*appdb.Asset, error) {
+	return appdb.Assets(
+		appdb.AssetWhere.ConfigurationID.EQ(null.Int64FromPtr(config.Id).Int64),
+		appdb.AssetWhere.AssetID.IsNotNull(),
+		appdb.AssetWhere.ForgottenAt.IsNull(),
+		qm.Load(appdb.AssetRels.Datapoints),
+		qm.OrderBy(appdb.AssetColumns.ID),
+	).AllG(ctx)
+}
+
+// RelinkAsset points the asset mapping to a re-created Eliona asset. Its datapoints follow the new
+// ID and its children refer to it as locational parent. Alarm rules of the old asset are dropped.
+func RelinkAsset(ctx context.Context, asset *appdb.Asset, assetID int32) error {
+	tx, err := boil.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("starting transaction: %v", err)
+	}
+	defer tx.Rollback()
+	oldID := asset.AssetID
+	if _, err := appdb.Assets(
+		appdb.AssetWhere.ConfigurationID.EQ(asset.ConfigurationID),
+		appdb.AssetWhere.LocationalParentID.EQ(oldID),
+	).UpdateAll(ctx, tx, appdb.M{appdb.AssetColumns.LocationalParentID: assetID}); err != nil {
+		return fmt.Errorf("updating children: %v", err)
+	}
+	if _, err := appdb.AlarmRules(appdb.AlarmRuleWhere.AssetID.EQ(oldID.Int32)).DeleteAll(ctx, tx); err != nil {
+		return fmt.Errorf("deleting alarm rules: %v", err)
+	}
+	asset.AssetID = null.Int32From(assetID)
+	if _, err := asset.Update(ctx, tx, boil.Whitelist(appdb.AssetColumns.AssetID)); err != nil {
+		return fmt.Errorf("updating asset: %v", err)
+	}
+	return tx.Commit()
+}
+
+// ForgetAsset drops the datapoints of an asset deleted in Eliona and marks it as forgotten, so
+// that it is not created again.
+func ForgetAsset(ctx context.Context, asset *appdb.Asset, at time.Time) error {
+	tx, err := boil.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("starting transaction: %v", err)
+	}
+	defer tx.Rollback()
+	if _, err := appdb.Datapoints(appdb.DatapointWhere.AssetID.EQ(asset.AssetID.Int32)).DeleteAll(ctx, tx); err != nil {
+		return fmt.Errorf("deleting datapoints: %v", err)
+	}
+	if _, err := appdb.AlarmRules(appdb.AlarmRuleWhere.AssetID.EQ(asset.AssetID.Int32)).DeleteAll(ctx, tx); err != nil {
+		return fmt.Errorf("deleting alarm rules: %v", err)
+	}
+	asset.ForgottenAt = null.TimeFrom(at)
+	if _, err := asset.Update(ctx, tx, boil.Whitelist(appdb.AssetColumns.ForgottenAt)); err != nil {
+		return fmt.Errorf("updating asset: %v", err)
+	}
+	return tx.Commit()
+}
+
+// GetDeviceAlerts returns the alerts currently raised for devices of the configuration.
+func GetDeviceAlerts(ctx context.Context, config apiserver.Configuration) ([]*appdb.DeviceAlert, error) {
+	return appdb.DeviceAlerts(
+		appdb.DeviceAlertWhere.ConfigurationID.EQ(null.Int64FromPtr(config.Id).Int64),
+	).AllG(ctx)
+}
+
+func RaiseDeviceAlert(ctx context.Context, config apiserver.Configuration, deviceGAI, kind string) error {
+	alert := appdb.DeviceAlert{
+		ConfigurationID: null.Int64FromPtr(config.Id).Int64,
+		DeviceGai:       deviceGAI,
+		Kind:            kind,
+	}
+	return alert.UpsertG(ctx, false, []string{"configuration_id", "device_gai", "kind"}, boil.None(), boil.Infer())
+}
+
+func ClearDeviceAlert(ctx context.Context, alert *appdb.DeviceAlert) error {
+	_, err := alert.DeleteG(ctx)
+	return err
+}
+
+// GetAlarmRuleID returns the ID of the Eliona alarm rule the app created for the attribute, or nil if none.
+func GetAlarmRuleID(ctx context.Context, assetID int32, attribute string) (*int32, error) {
+	rule, err := appdb.FindAlarmRuleG(ctx, assetID, attribute)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return &rule.AlarmRuleID, nil
+}
+
+func SetAlarmRuleID(ctx context.Context, assetID int32, attribute string, alarmRuleID int32) error {
+	rule := appdb.AlarmRule{
+		AssetID:     assetID,
+		Attribute:   attribute,
+		AlarmRuleID: alarmRuleID,
+	}
+	return rule.UpsertG(ctx, true, []string{"asset_id", "attribute"}, boil.Whitelist("alarm_rule_id"), boil.Infer())
+}
+
+// RecordSystemConnection stores a change of the connection state of a SysAP. Nothing is stored
+// if the state is the same as the last recorded one.
+func RecordSystemConnection(ctx context.Context, config apiserver.Configuration, systemID string, connected bool, at time.Time) error {
+	last, err := appdb.SystemConnectionEvents(
+		appdb.SystemConnectionEventWhere.ConfigurationID.EQ(null.Int64FromPtr(config.Id).Int64),
+		appdb.SystemConnectionEventWhere.SystemID.EQ(systemID),
+		qm.OrderBy(appdb.SystemConnectionEventColumns.ChangedAt+" desc, "+appdb.SystemConnectionEventColumns.ID+" desc"),
+	).OneG(ctx)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+	if last != nil && last.Connected == connected {
+		return nil
+	}
+	event := appdb.SystemConnectionEvent{
+		ConfigurationID: null.Int64FromPtr(config.Id).Int64,
+		SystemID:        systemID,
+		Connected:       connected,
+		ChangedAt:       at,
+	}
+	return event.InsertG(ctx, boil.Infer())
+}
+
+// GetPendingOutageNotifications returns the outages of SysAPs still disconnected since before
+// the given time the user was not notified about yet.
+func GetPendingOutageNotifications(ctx context.Context, config apiserver.Configuration, before time.Time) ([]*appdb.SystemConnectionEvent, error) {
+	var events []*appdb.SystemConnectionEvent
+	err := queries.Raw(`
+		select * from (
+			select distinct on (system_id) *
+			from abb_free_at_home.system_connection_event
+			where configuration_id = $1
+			order by system_id, changed_at desc, id desc
+		) last
+		where not connected and notified_at is null and changed_at < $2`,
+		null.Int64FromPtr(config.Id).Int64, before,
+	).BindG(ctx, &events)
+	return events, err
+}
+
+func MarkOutageNotified(ctx context.Context, event *appdb.SystemConnectionEvent, at time.Time) error {
+	event.NotifiedAt = null.TimeFrom(at)
+	_, err := event.UpdateG(ctx, boil.Whitelist(appdb.SystemConnectionEventColumns.NotifiedAt))
+	return err
+}
+
+// GetSystemAvailability computes the uptime and outage statistics of all SysAPs of the
+// configuration for the period from the recorded connection state changes. The period
+// ends now at the latest.
+func GetSystemAvailability(ctx context.Context, config apiserver.Configuration, from, to time.Time) ([]apiserver.SystemAvailability, error) {
+	if now := time.Now(); to.After(now) {
+		to = now
+	}
+	if from.After(to) {
+		from = to
+	}
+	configID := null.Int64FromPtr(config.Id).Int64
+
+	// The changes within the period, together with the last one before as the starting state.
+	var events []*appdb.SystemConnectionEvent
+	if err := queries.Raw(`
+		select * from abb_free_at_home.system_connection_event
+		where configuration_id = $1 and changed_at > $2 and changed_at < $3
+		union all (
+			select distinct on (system_id) *
+			from abb_free_at_home.system_connection_event
+			where configuration_id = $1 and changed_at <= $2
+			order by system_id, changed_at desc, id desc
+		)
+		order by changed_at, id`,
+		configID, from, to,
+	).BindG(ctx, &events); err != nil {
+		return nil, fmt.Errorf("fetching connection events: %v", err)
+	}
+	var current []*appdb.SystemConnectionEvent
+	if err := queries.Raw(`
+		select distinct on (system_id) *
+		from abb_free_at_home.system_connection_event
+		where configuration_id = $1
+		order by system_id, changed_at desc, id desc`,
+		configID,
+	).BindG(ctx, &current); err != nil {
+		return nil, fmt.Errorf("fetching current connection states: %v", err)
+	}
+	systems, err := GetSystems(ctx, config)
+	if err != nil {
+		return nil, fmt.Errorf("fetching systems: %v", err)
+	}
+
+	// Systems without any recorded state are reported with the whole period unknown.
+	eventsBySystem := make(map[string][]*appdb.SystemConnectionEvent)
+	for _, system := range systems {
+		eventsBySystem[system.ProviderID] = nil
+	}
+	for _, event := range events {
+		eventsBySystem[event.SystemID] = append(eventsBySystem[event.SystemID], event)
+	}
+	currentBySystem := make(map[string]*appdb.SystemConnectionEvent)
+	for _, event := range current {
+		currentBySystem[event.SystemID] = event
+		if _, ok := eventsBySystem[event.SystemID]; !ok {
+			eventsBySystem[event.SystemID] = nil
+		}
+	}
+
+	var availabilities []apiserver.SystemAvailability
+	for _, systemID := range slices.Sorted(maps.Keys(eventsBySystem)) {
+		availabilities = append(availabilities, systemAvailability(systemID, eventsBySystem[systemID], currentBySystem[systemID], from, to))
+	}
+	return availabilities, nil
+}
+
+// systemAvailability evaluates the connection events of one SysAP, ordered by time. The
+// current state is reported from the last recorded event, nil if there is none.
+func systemAvailability(systemID string, events []*appdb.SystemConnectionEvent, current *appdb.SystemConnectionEvent, from, to time.Time) apiserver.SystemAvailability {
+	availability := apiserver.SystemAvailability{
+		SystemId: systemID,
+		From:     from,
+		To:       to,
+	}
+	var (
+		connected   *bool // State at the cursor, nil if unknown.
+		cursor      = from
+		outageStart time.Time
+	)
+	account := func(until time.Time) {
+		seconds := int64(until.Sub(cursor).Seconds())
+		switch {
+		case connected == nil:
+			availability.UnknownSeconds += seconds
+		case *connected:
+			availability.ConnectedSeconds += seconds
+		default:
+			availability.DisconnectedSeconds += seconds
+			availability.LongestOutageSeconds = max(availability.LongestOutageSeconds, int64(until.Sub(outageStart).Seconds()))
+		}
+		cursor = until
+	}
+
+	i := 0
+	for ; i < len(events) && !events[i].ChangedAt.After(from); i++ {
+		connected = &events[i].Connected
+	}
+	if connected != nil && !*connected {
+		// The period starts during an outage.
+		availability.Outages++
+		outageStart = from
+	}
+	for ; i < len(events) && events[i].ChangedAt.Before(to); i++ {
+		event := events[i]
+		if connected != nil && *connected == event.Connected {
+			continue
+		}
+		account(event.ChangedAt)
+		connected = &event.Connected
+		if !event.Connected {
+			availability.Outages++
+			outageStart = event.ChangedAt
+		}
+	}
+	account(to)
+
+	if known := availability.ConnectedSeconds + availability.DisconnectedSeconds; known > 0 {
+		availability.Availability = common.Ptr(float64(availability.ConnectedSeconds) / float64(known))
+	}
+	if current != nil {
+		availability.Connected = &current.Connected
+		availability.ChangedAt = &current.ChangedAt
+	}
+	return availability
+}
+
+// DiscoverySchedule returns when the devices and locations of the configuration are discovered.
+func DiscoverySchedule(config apiserver.Configuration) schedule.Schedule {
+	s := schedule.Schedule{
+		Interval: time.Duration(common.Val(config.DiscoveryInterval)) * time.Second,
+	}
+	// The expressions are validated when the configuration is stored.
+	if cron := common.Val(config.DiscoveryCron); cron != "" {
+		s.Cron, _ = schedule.ParseCron(cron)
+	}
+	if quietHours := common.Val(config.DiscoveryQuietHours); quietHours != "" {
+		s.QuietHours, _ = schedule.ParseQuietHours(quietHours)
+	}
+	return s
+}
+
+// ValueRefreshSchedule returns when the values of all devices of the configuration are refreshed.
+func ValueRefreshSchedule(config apiserver.Configuration) schedule.Schedule {
+	s := schedule.Schedule{
+		Interval: time.Duration(config.RefreshInterval) * time.Second,
+	}
+	if cron := common.Val(config.RefreshCron); cron != "" {
+		s.Cron, _ = schedule.ParseCron(cron)
+	}
+	return s
+}
+
+func CreateSyncJob(ctx context.Context, configID int64, mode string) (apiserver.SyncJob, error) {
+	switch mode {
+	case SYNC_MODE_DISCOVERY, SYNC_MODE_VALUES, SYNC_MODE_RECONCILE:
+	default:
+		return apiserver.SyncJob{}, fmt.Errorf("%w: unknown sync mode '%s'", ErrBadRequest, mode)
+	}
+	job := appdb.SyncJob{
+		ConfigurationID: configID,
+		Mode:            mode,
+		Status:          SYNC_STATUS_PENDING,
+		Changes:         []byte("[]"),
+		Errors:          []byte("[]"),
+	}
+	if err := job.InsertG(ctx, boil.Infer()); err != nil {
+		return apiserver.SyncJob{}, err
+	}
+	return apiSyncJobFromDbSyncJob(&job)
+}
+
+// GetSyncJob returns the job of the configuration, or nil if there is none with the ID.
+func GetSyncJob(ctx context.Context, configID int64, jobID int64) (*apiserver.SyncJob, error) {
+	job, err := appdb.SyncJobs(
+		appdb.SyncJobWhere.ID.EQ(jobID),
+		appdb.SyncJobWhere.ConfigurationID.EQ(configID),
+	).OneG(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	apiJob, err := apiSyncJobFromDbSyncJob(job)
+	if err != nil {
+		return nil, err
+	}
+	return &apiJob, nil
+}
+
+// StartSyncJobs marks the pending jobs of the configuration with one of the modes as running
+// and returns them.
+func StartSyncJobs(ctx context.Context, configID int64, modes ...string) ([]*appdb.SyncJob, error) {
+	jobs, err := appdb.SyncJobs(
+		appdb.SyncJobWhere.ConfigurationID.EQ(configID),
+		appdb.SyncJobWhere.Status.EQ(SYNC_STATUS_PENDING),
+		appdb.SyncJobWhere.Mode.IN(modes),
+	).AllG(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, job := range jobs {
+		job.Status = SYNC_STATUS_RUNNING
+		job.StartedAt = null.TimeFrom(time.Now())
+		if _, err := job.UpdateG(ctx, boil.Whitelist(appdb.SyncJobColumns.Status, appdb.SyncJobColumns.StartedAt)); err != nil {
+			return nil, err
+		}
+	}
+	return jobs, nil
+}
+
+func UpdateSyncJob(ctx context.Context, job *appdb.SyncJob) error {
+	_, err := job.UpdateG(ctx, boil.Infer())
+	return err
+}
+
+// FailInterruptedSyncJobs marks the jobs still running from before a restart of the app as failed.
+func FailInterruptedSyncJobs(ctx context.Context) error {
+	_, err := appdb.SyncJobs(
+		appdb.SyncJobWhere.Status.EQ(SYNC_STATUS_RUNNING),
+	).UpdateAllG(ctx, appdb.M{
+		appdb.SyncJobColumns.Status:     SYNC_STATUS_FAILED,
+		appdb.SyncJobColumns.FinishedAt: time.Now(),
+		appdb.SyncJobColumns.Errors:     []byte(`["interrupted by a restart of the app"]`),
+	})
+	return err
+}
+
+// FailPendingSyncJobs marks the pending jobs of a disabled configuration as failed.
+func FailPendingSyncJobs(ctx context.Context, configID int64) error {
+	_, err := appdb.SyncJobs(
+		appdb.SyncJobWhere.ConfigurationID.EQ(configID),
+		appdb.SyncJobWhere.Status.EQ(SYNC_STATUS_PENDING),
+	).UpdateAllG(ctx, appdb.M{
+		appdb.SyncJobColumns.Status:     SYNC_STATUS_FAILED,
+		appdb.SyncJobColumns.FinishedAt: time.Now(),
+		appdb.SyncJobColumns.Errors:     []byte(`["configuration disabled"]`),
+	})
+	return err
+}
+
+func apiSyncJobFromDbSyncJob(job *appdb.SyncJob) (apiserver.SyncJob, error) {
+	apiJob := apiserver.SyncJob{
+		Id:             job.ID,
+		ConfigId:       job.ConfigurationID,
+		Mode:           job.Mode,
+		Status:         job.Status,
+		Progress:       job.Progress,
+		Step:           job.Step,
+		AssetsCreated:  job.AssetsCreated,
+		AssetsUpdated:  job.AssetsUpdated,
+		AssetsOrphaned: job.AssetsOrphaned,
+		AssetsRetired:  job.AssetsRetired,
+		Changes:        []string{},
+		Errors:         []string{},
+		CreatedAt:      job.CreatedAt,
+		StartedAt:      job.StartedAt.Ptr(),
+		FinishedAt:     job.FinishedAt.Ptr(),
+	}
+	if err := json.Unmarshal(job.Changes, &apiJob.Changes); err != nil {
+		return apiserver.SyncJob{}, fmt.Errorf("unmarshalling changes: %v", err)
+	}
+	if err := json.Unmarshal(job.Errors, &apiJob.Errors); err != nil {
+		return apiserver.SyncJob{}, fmt.Errorf("unmarshalling errors: %v", err)
+	}
+	return apiJob, nil
+}
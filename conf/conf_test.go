@@ -0,0 +1,190 @@
+package conf
+
+import (
+	"abb-free-at-home/apiserver"
+	"abb-free-at-home/appdb"
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/eliona-smart-building-assistant/go-utils/common"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+)
+
+// TestConfigZeroSettings checks that settings set to 0 are inserted, instead of being replaced
+// by their column default, and read back as 0.
+func TestConfigZeroSettings(t *testing.T) {
+	tests := []struct {
+		column string
+		set    func(*apiserver.Configuration)
+		get    func(apiserver.Configuration) any
+	}{
+		{
+			appdb.ConfigurationColumns.BackfillThreshold,
+			func(c *apiserver.Configuration) { c.BackfillThreshold = common.Ptr[int32](0) },
+			func(c apiserver.Configuration) any { return common.Val(c.BackfillThreshold) },
+		},
+		{
+			appdb.ConfigurationColumns.OfflineNotificationThreshold,
+			func(c *apiserver.Configuration) { c.OfflineNotificationThreshold = common.Ptr[int32](0) },
+			func(c apiserver.Configuration) any { return common.Val(c.OfflineNotificationThreshold) },
+		},
+		{
+			appdb.ConfigurationColumns.LowBatteryThreshold,
+			func(c *apiserver.Configuration) { c.LowBatteryThreshold = common.Ptr[int32](0) },
+			func(c apiserver.Configuration) any { return common.Val(c.LowBatteryThreshold) },
+		},
+		{
+			appdb.ConfigurationColumns.WeakSignalThreshold,
+			func(c *apiserver.Configuration) { c.WeakSignalThreshold = common.Ptr[int32](0) },
+			func(c apiserver.Configuration) any { return common.Val(c.WeakSignalThreshold) },
+		},
+		{
+			appdb.ConfigurationColumns.AlertHysteresis,
+			func(c *apiserver.Configuration) { c.AlertHysteresis = common.Ptr[int32](0) },
+			func(c apiserver.Configuration) any { return common.Val(c.AlertHysteresis) },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.column, func(t *testing.T) {
+			apiConfig := apiserver.Configuration{RefreshInterval: 60}
+			tt.set(&apiConfig)
+			dbConfig, err := dbConfigFromApiConfig(context.Background(), apiConfig)
+			if err != nil {
+				t.Fatalf("dbConfigFromApiConfig() error = %v", err)
+			}
+			// The column set sqlboiler inserts, with the column having a default.
+			defaults := []string{tt.column}
+			inserted, _ := configColumns(apiConfig).InsertColumnSet(defaults, defaults, nil, queries.NonZeroDefaultSet(defaults, &dbConfig))
+			if !slices.Contains(inserted, tt.column) {
+				t.Errorf("%s set to 0 is not inserted", tt.column)
+			}
+			readBack, err := apiConfigFromDbConfig(&dbConfig)
+			if err != nil {
+				t.Fatalf("apiConfigFromDbConfig() error = %v", err)
+			}
+			if got := tt.get(readBack); got != tt.get(apiConfig) {
+				t.Errorf("%s read back as %v, want %v", tt.column, got, tt.get(apiConfig))
+			}
+		})
+	}
+}
+
+func TestConfigOmittedSettings(t *testing.T) {
+	apiConfig := apiserver.Configuration{RefreshInterval: 60}
+	dbConfig, err := dbConfigFromApiConfig(context.Background(), apiConfig)
+	if err != nil {
+		t.Fatalf("dbConfigFromApiConfig() error = %v", err)
+	}
+	// Omitted settings keep their column default.
+	defaults := []string{
+		appdb.ConfigurationColumns.BackfillThreshold,
+		appdb.ConfigurationColumns.OfflineNotificationThreshold,
+		appdb.ConfigurationColumns.LowBatteryThreshold,
+		appdb.ConfigurationColumns.WeakSignalThreshold,
+		appdb.ConfigurationColumns.AlertHysteresis,
+	}
+	inserted, _ := configColumns(apiConfig).InsertColumnSet(defaults, defaults, nil, queries.NonZeroDefaultSet(defaults, &dbConfig))
+	if len(inserted) != 0 {
+		t.Errorf("omitted settings inserted: %v", inserted)
+	}
+}
+
+func TestConfigValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		set     func(*apiserver.Configuration)
+		wantErr bool
+	}{
+		{"defaults", func(c *apiserver.Configuration) {}, false},
+		{"buffer size", func(c *apiserver.Configuration) { c.BufferSize = common.Ptr[int32](1) }, false},
+		{"empty buffer", func(c *apiserver.Configuration) { c.BufferSize = common.Ptr[int32](0) }, true},
+		{"negative buffer", func(c *apiserver.Configuration) { c.BufferSize = common.Ptr[int32](-1) }, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiConfig := apiserver.Configuration{RefreshInterval: 60}
+			tt.set(&apiConfig)
+			_, err := dbConfigFromApiConfig(context.Background(), apiConfig)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("dbConfigFromApiConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrBadRequest) {
+				t.Errorf("dbConfigFromApiConfig() error = %v, want ErrBadRequest", err)
+			}
+		})
+	}
+}
+
+func TestSystemAvailability(t *testing.T) {
+	at := func(hour, min int) time.Time { return time.Date(2024, time.January, 1, hour, min, 0, 0, time.UTC) }
+	event := func(connected bool, changedAt time.Time) *appdb.SystemConnectionEvent {
+		return &appdb.SystemConnectionEvent{SystemID: "sys", Connected: connected, ChangedAt: changedAt}
+	}
+	from, to := at(10, 0), at(12, 0)
+	tests := []struct {
+		name   string
+		events []*appdb.SystemConnectionEvent
+		want   apiserver.SystemAvailability
+	}{
+		{"no events", nil, apiserver.SystemAvailability{UnknownSeconds: 7200}},
+		{"connected throughout", []*appdb.SystemConnectionEvent{event(true, at(9, 0))}, apiserver.SystemAvailability{
+			ConnectedSeconds: 7200, Availability: common.Ptr(1.0),
+		}},
+		{"one outage", []*appdb.SystemConnectionEvent{
+			event(true, at(9, 0)),
+			event(false, at(10, 30)),
+			event(false, at(11, 0)),
+			event(true, at(11, 15)),
+		}, apiserver.SystemAvailability{
+			ConnectedSeconds: 4500, DisconnectedSeconds: 2700, Outages: 1, LongestOutageSeconds: 2700, Availability: common.Ptr(0.625),
+		}},
+		{"starts during outage", []*appdb.SystemConnectionEvent{
+			event(false, at(9, 0)),
+			event(true, at(10, 15)),
+		}, apiserver.SystemAvailability{
+			ConnectedSeconds: 6300, DisconnectedSeconds: 900, Outages: 1, LongestOutageSeconds: 900, Availability: common.Ptr(0.875),
+		}},
+		{"first state within period", []*appdb.SystemConnectionEvent{event(true, at(10, 30))}, apiserver.SystemAvailability{
+			UnknownSeconds: 1800, ConnectedSeconds: 5400, Availability: common.Ptr(1.0),
+		}},
+		{"ends during outage", []*appdb.SystemConnectionEvent{
+			event(true, at(9, 0)),
+			event(false, at(11, 0)),
+			event(true, at(11, 20)),
+			event(false, at(11, 30)),
+		}, apiserver.SystemAvailability{
+			ConnectedSeconds: 4200, DisconnectedSeconds: 3000, Outages: 2, LongestOutageSeconds: 1800, Availability: common.Ptr(4200.0 / 7200),
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := systemAvailability("sys", tt.events, nil, from, to)
+			want := tt.want
+			if got.UnknownSeconds != want.UnknownSeconds || got.ConnectedSeconds != want.ConnectedSeconds ||
+				got.DisconnectedSeconds != want.DisconnectedSeconds || got.Outages != want.Outages ||
+				got.LongestOutageSeconds != want.LongestOutageSeconds {
+				t.Errorf("systemAvailability() = %+v, want %+v", got, want)
+			}
+			if (got.Availability == nil) != (want.Availability == nil) ||
+				got.Availability != nil && *got.Availability != *want.Availability {
+				t.Errorf("availability = %v, want %v", common.Val(got.Availability), common.Val(want.Availability))
+			}
+		})
+	}
+}
+
+func TestSystemAvailabilityCurrentState(t *testing.T) {
+	from := time.Date(2024, time.January, 1, 10, 0, 0, 0, time.UTC)
+	current := &appdb.SystemConnectionEvent{SystemID: "sys", Connected: false, ChangedAt: from.Add(48 * time.Hour)}
+	got := systemAvailability("sys", nil, current, from, from.Add(time.Hour))
+	if got.Connected == nil || *got.Connected || got.ChangedAt == nil || !got.ChangedAt.Equal(current.ChangedAt) {
+		t.Errorf("systemAvailability() reports current state %v since %v, want false since %v",
+			common.Val(got.Connected), common.Val(got.ChangedAt), current.ChangedAt)
+	}
+	if got.UnknownSeconds != 3600 {
+		t.Errorf("systemAvailability() unknown seconds = %d, want 3600", got.UnknownSeconds)
+	}
+}
@@ -0,0 +1,430 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package conf
+
+import (
+	"abb-free-at-home/apiserver"
+	"abb-free-at-home/appdb"
+	"abb-free-at-home/model"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+const exportVersion = 1
+
+// ExportConfigs serializes the configurations together with their asset, datapoint and attribute
+// mappings. The mappings refer to the assets by GAI, as the Eliona asset IDs differ between
+// environments. With a passphrase, the secrets are encrypted.
+func ExportConfigs(ctx context.Context, configIDs []int64, passphrase string) (apiserver.ConfigurationExport, error) {
+	var mods []qm.QueryMod
+	if len(configIDs) > 0 {
+		mods = append(mods, appdb.ConfigurationWhere.ID.IN(configIDs))
+	}
+	mods = append(mods, qm.OrderBy(appdb.ConfigurationColumns.ID))
+	dbConfigs, err := appdb.Configurations(mods...).AllG(ctx)
+	if err != nil {
+		return apiserver.ConfigurationExport{}, fmt.Errorf("fetching configs: %v", err)
+	}
+	if len(dbConfigs) < len(configIDs) {
+		return apiserver.ConfigurationExport{}, fmt.Errorf("%w: unknown configuration", ErrBadRequest)
+	}
+	export := apiserver.ConfigurationExport{
+		Version:        exportVersion,
+		ExportedAt:     time.Now(),
+		Configurations: []apiserver.ExportedConfiguration{},
+	}
+	for _, dbConfig := range dbConfigs {
+		config, err := apiConfigFromDbConfig(dbConfig)
+		if err != nil {
+			return apiserver.ConfigurationExport{}, fmt.Errorf("creating API config from DB config: %v", err)
+		}
+		exported := apiserver.ExportedConfiguration{Configuration: config}
+		exported.Configuration.Id = nil
+		exported.Configuration.Active = nil
+		exported.Configuration.UserId = nil
+		if passphrase != "" {
+			encrypted, err := encryptSecrets(takeSecrets(&exported.Configuration), passphrase)
+			if err != nil {
+				return apiserver.ConfigurationExport{}, fmt.Errorf("encrypting secrets of config %d: %v", dbConfig.ID, err)
+			}
+			exported.EncryptedSecrets = &encrypted
+		}
+		exported.Assets, err = exportAssets(ctx, dbConfig.ID)
+		if err != nil {
+			return apiserver.ConfigurationExport{}, fmt.Errorf("exporting assets of config %d: %v", dbConfig.ID, err)
+		}
+		export.Configurations = append(export.Configurations, exported)
+	}
+	return export, nil
+}
+
+func exportAssets(ctx context.Context, configID int64) ([]apiserver.ExportedAsset, error) {
+	dbAssets, err := appdb.Assets(
+		appdb.AssetWhere.ConfigurationID.EQ(configID),
+		qm.Load(qm.Rels(appdb.AssetRels.Datapoints, appdb.DatapointRels.DatapointAttributes)),
+		qm.OrderBy(appdb.AssetColumns.ID),
+	).AllG(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gais := make(map[int32]string)
+	for _, a := range dbAssets {
+		if a.AssetID.Valid {
+			gais[a.AssetID.Int32] = a.GlobalAssetID
+		}
+	}
+	assets := []apiserver.ExportedAsset{}
+	for _, a := range dbAssets {
+		asset := apiserver.ExportedAsset{
+			ProjectId:   a.ProjectID,
+			Gai:         a.GlobalAssetID,
+			AssetType:   a.AssetTypeName,
+			ProviderId:  a.ProviderID,
+			Name:        a.Name.Ptr(),
+			OrphanedAt:  a.OrphanedAt.Ptr(),
+			RetiredAt:   a.RetiredAt.Ptr(),
+			ForgottenAt: a.ForgottenAt.Ptr(),
+			Datapoints:  []apiserver.ExportedDatapoint{},
+		}
+		if gai, ok := gais[a.LocationalParentID.Int32]; ok && a.LocationalParentID.Valid {
+			asset.LocationalParent = &gai
+		}
+		if a.R != nil {
+			for _, dp := range a.R.Datapoints {
+				datapoint := apiserver.ExportedDatapoint{
+					SystemId:   dp.SystemID,
+					DeviceId:   dp.DeviceID,
+					ChannelId:  dp.ChannelID,
+					Datapoint:  dp.Datapoint,
+					Function:   dp.Function,
+					IsInput:    dp.IsInput,
+					Dpt:        dp.DPT.Ptr(),
+					Attributes: []apiserver.ExportedDatapointAttribute{},
+				}
+				if dp.R != nil {
+					for _, attr := range dp.R.DatapointAttributes {
+						rules, err := TransformationRules(attr)
+						if err != nil {
+							return nil, err
+						}
+						datapoint.Attributes = append(datapoint.Attributes, apiserver.ExportedDatapointAttribute{
+							Subtype:        attr.Subtype,
+							AttributeName:  attr.AttributeName,
+							Transformation: rules,
+						})
+					}
+				}
+				asset.Datapoints = append(asset.Datapoints, datapoint)
+			}
+		}
+		assets = append(assets, asset)
+	}
+	return assets, nil
+}
+
+// ImportConfigs creates the exported configurations with their mappings in one transaction. The
+// configurations keep their GAI scope, so that the assets are linked to the Eliona assets with
+// the same GAI instead of being created anew. elionaAssetIDs returns the IDs of the Eliona
+// assets of a project keyed by GAI. Assets not found in Eliona are created by the next discovery.
+func ImportConfigs(ctx context.Context, export apiserver.ConfigurationExport, passphrase string, elionaAssetIDs func(projectId string) (map[string]int32, error)) ([]apiserver.ConfigurationImportResult, error) {
+	if export.Version != exportVersion {
+		return nil, fmt.Errorf("%w: unsupported export version %d", ErrBadRequest, export.Version)
+	}
+	tx, err := boil.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("starting transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	projectAssetIDs := make(map[string]map[string]int32)
+	results := []apiserver.ConfigurationImportResult{}
+	for _, exported := range export.Configurations {
+		result, err := importConfig(ctx, tx, exported, passphrase, func(projectId string) (map[string]int32, error) {
+			if ids, ok := projectAssetIDs[projectId]; ok {
+				return ids, nil
+			}
+			ids, err := elionaAssetIDs(projectId)
+			if err != nil {
+				return nil, fmt.Errorf("fetching Eliona assets of project %s: %v", projectId, err)
+			}
+			projectAssetIDs[projectId] = ids
+			return ids, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("committing import: %v", err)
+	}
+	return results, nil
+}
+
+var generatedScope = regexp.MustCompile(`^cfg(\d+)$`)
+
+func importConfig(ctx context.Context, tx boil.ContextTransactor, exported apiserver.ExportedConfiguration, passphrase string, elionaAssetIDs func(projectId string) (map[string]int32, error)) (apiserver.ConfigurationImportResult, error) {
+	config := exported.Configuration
+	config.Id = nil
+	config.Active = nil
+	if exported.EncryptedSecrets != nil {
+		if passphrase == "" {
+			return apiserver.ConfigurationImportResult{}, fmt.Errorf("%w: the secrets are encrypted, a passphrase is needed", ErrBadRequest)
+		}
+		if err := decryptSecrets(*exported.EncryptedSecrets, passphrase, &config); err != nil {
+			return apiserver.ConfigurationImportResult{}, err
+		}
+	}
+	if config.GaiScope == nil {
+		return apiserver.ConfigurationImportResult{}, fmt.Errorf("%w: configuration without gaiScope", ErrBadRequest)
+	}
+	scope := *config.GaiScope
+	existing, err := appdb.Configurations().All(ctx, tx)
+	if err != nil {
+		return apiserver.ConfigurationImportResult{}, fmt.Errorf("fetching configs: %v", err)
+	}
+	for _, c := range existing {
+		if gaiScope(c) == scope {
+			return apiserver.ConfigurationImportResult{}, fmt.Errorf("%w: configuration %d already uses the gaiScope '%s'", ErrBadRequest, c.ID, scope)
+		}
+	}
+	if m := generatedScope.FindStringSubmatch(scope); m != nil {
+		// Configurations created later derive their scope from their ID, which must not collide.
+		n, _ := strconv.ParseInt(m[1], 10, 64)
+		if _, err := queries.Raw(`select setval(pg_get_serial_sequence('abb_free_at_home.configuration', 'id'),
+			greatest($1, nextval(pg_get_serial_sequence('abb_free_at_home.configuration', 'id'))))`, n).ExecContext(ctx, tx); err != nil {
+			return apiserver.ConfigurationImportResult{}, fmt.Errorf("advancing config ID sequence: %v", err)
+		}
+	}
+
+	dbConfig, err := dbConfigFromApiConfig(ctx, config)
+	if err != nil {
+		return apiserver.ConfigurationImportResult{}, fmt.Errorf("creating DB config from API config: %w", err)
+	}
+	dbConfig.GaiScope = null.StringFrom(scope)
+	if err := dbConfig.Insert(ctx, tx, configColumns(config)); err != nil {
+		return apiserver.ConfigurationImportResult{}, fmt.Errorf("inserting config: %v", err)
+	}
+	config.Id = &dbConfig.ID
+	result := apiserver.ConfigurationImportResult{
+		ConfigId: dbConfig.ID,
+		GaiScope: scope,
+	}
+
+	type linked struct {
+		exported apiserver.ExportedAsset
+		dbAsset  *appdb.Asset
+	}
+	var assets []linked
+	for _, a := range exported.Assets {
+		dbAsset := &appdb.Asset{
+			ConfigurationID: dbConfig.ID,
+			ProjectID:       a.ProjectId,
+			GlobalAssetID:   a.Gai,
+			AssetTypeName:   a.AssetType,
+			ProviderID:      a.ProviderId,
+			Name:            null.StringFromPtr(a.Name),
+			OrphanedAt:      null.TimeFromPtr(a.OrphanedAt),
+			RetiredAt:       null.TimeFromPtr(a.RetiredAt),
+			ForgottenAt:     null.TimeFromPtr(a.ForgottenAt),
+		}
+		ids, err := elionaAssetIDs(a.ProjectId)
+		if err != nil {
+			return apiserver.ConfigurationImportResult{}, err
+		}
+		if id, ok := ids[ElionaGAI(config, a.Gai)]; ok {
+			taken, err := appdb.Assets(appdb.AssetWhere.AssetID.EQ(null.Int32From(id))).Exists(ctx, tx)
+			if err != nil {
+				return apiserver.ConfigurationImportResult{}, fmt.Errorf("checking asset %d: %v", id, err)
+			}
+			if taken {
+				return apiserver.ConfigurationImportResult{}, fmt.Errorf("%w: Eliona asset %d of '%s' is already used by another configuration", ErrBadRequest, id, a.Gai)
+			}
+			dbAsset.AssetID = null.Int32From(id)
+			result.AssetsLinked++
+		} else {
+			result.AssetsMissing++
+		}
+		assets = append(assets, linked{exported: a, dbAsset: dbAsset})
+	}
+
+	// The locational parents refer to the linked Eliona assets, so they are resolved after linking.
+	assetIDs := make(map[string]null.Int32)
+	for _, a := range assets {
+		assetIDs[a.exported.ProjectId+"/"+a.exported.Gai] = a.dbAsset.AssetID
+	}
+	for _, a := range assets {
+		if a.exported.LocationalParent != nil {
+			a.dbAsset.LocationalParentID = assetIDs[a.exported.ProjectId+"/"+*a.exported.LocationalParent]
+		}
+		if err := a.dbAsset.Insert(ctx, tx, boil.Infer()); err != nil {
+			return apiserver.ConfigurationImportResult{}, fmt.Errorf("inserting asset '%s': %v", a.exported.Gai, err)
+		}
+		if !a.dbAsset.AssetID.Valid {
+			// Datapoints need the Eliona asset. The discovery creates them together with it.
+			continue
+		}
+		for _, dp := range a.exported.Datapoints {
+			dbDatapoint := appdb.Datapoint{
+				AssetID:   a.dbAsset.AssetID.Int32,
+				SystemID:  dp.SystemId,
+				DeviceID:  dp.DeviceId,
+				ChannelID: dp.ChannelId,
+				Datapoint: dp.Datapoint,
+				Function:  dp.Function,
+				IsInput:   dp.IsInput,
+				DPT:       null.StringFromPtr(dp.Dpt),
+			}
+			if err := dbDatapoint.Insert(ctx, tx, boil.Infer()); err != nil {
+				return apiserver.ConfigurationImportResult{}, fmt.Errorf("inserting datapoint %s of '%s': %v", dp.Datapoint, a.exported.Gai, err)
+			}
+			for _, attr := range dp.Attributes {
+				dbAttr := appdb.DatapointAttribute{
+					DatapointID:   dbDatapoint.ID,
+					Subtype:       attr.Subtype,
+					AttributeName: attr.AttributeName,
+				}
+				if len(attr.Transformation) > 0 {
+					if err := model.ValidateTransformation(attr.Transformation); err != nil {
+						return apiserver.ConfigurationImportResult{}, fmt.Errorf("%w: transformation of %s/%s of '%s': %v", ErrBadRequest, attr.Subtype, attr.AttributeName, a.exported.Gai, err)
+					}
+					rules, err := json.Marshal(attr.Transformation)
+					if err != nil {
+						return apiserver.ConfigurationImportResult{}, fmt.Errorf("marshalling transformation: %v", err)
+					}
+					dbAttr.Transformation = null.JSONFrom(rules)
+				}
+				if err := dbAttr.Insert(ctx, tx, boil.Infer()); err != nil {
+					return apiserver.ConfigurationImportResult{}, fmt.Errorf("linking datapoint %s of '%s': %v", dp.Datapoint, a.exported.Gai, err)
+				}
+			}
+			result.Datapoints++
+		}
+	}
+	return result, nil
+}
+
+// configSecrets are the fields of a configuration encrypted in exports.
+type configSecrets struct {
+	ApiKey       *string `json:"apiKey,omitempty"`
+	ClientSecret *string `json:"clientSecret,omitempty"`
+	AccessToken  *string `json:"accessToken,omitempty"`
+	RefreshToken *string `json:"refreshToken,omitempty"`
+	ApiPassword  *string `json:"apiPassword,omitempty"`
+}
+
+// takeSecrets removes the secrets from the configuration and returns them.
+func takeSecrets(config *apiserver.Configuration) configSecrets {
+	secrets := configSecrets{
+		ApiKey:       config.ApiKey,
+		ClientSecret: config.ClientSecret,
+		AccessToken:  config.AccessToken,
+		RefreshToken: config.RefreshToken,
+		ApiPassword:  config.ApiPassword,
+	}
+	config.ApiKey = nil
+	config.ClientSecret = nil
+	config.AccessToken = nil
+	config.RefreshToken = nil
+	config.ApiPassword = nil
+	return secrets
+}
+
+const (
+	saltSize         = 16
+	keyIterations    = 600000
+	secretsKeyLength = 32
+)
+
+// encryptSecrets encrypts the secrets with AES-GCM and a key derived from the passphrase. The
+// result contains the salt, nonce and ciphertext, base64 encoded.
+func encryptSecrets(secrets configSecrets, passphrase string) (string, error) {
+	plaintext, err := json.Marshal(secrets)
+	if err != nil {
+		return "", fmt.Errorf("marshalling secrets: %v", err)
+	}
+	salt := make([]byte, saltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("generating salt: %v", err)
+	}
+	gcm, err := secretsCipher(passphrase, salt)
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("generating nonce: %v", err)
+	}
+	sealed := gcm.Seal(append(salt, nonce...), nonce, plaintext, nil)
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// decryptSecrets decrypts the secrets and sets them in the configuration.
+func decryptSecrets(encrypted string, passphrase string, config *apiserver.Configuration) error {
+	sealed, err := base64.StdEncoding.DecodeString(encrypted)
+	if err != nil || len(sealed) < saltSize {
+		return fmt.Errorf("%w: malformed encrypted secrets", ErrBadRequest)
+	}
+	gcm, err := secretsCipher(passphrase, sealed[:saltSize])
+	if err != nil {
+		return err
+	}
+	sealed = sealed[saltSize:]
+	if len(sealed) < gcm.NonceSize() {
+		return fmt.Errorf("%w: malformed encrypted secrets", ErrBadRequest)
+	}
+	plaintext, err := gcm.Open(nil, sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():], nil)
+	if err != nil {
+		return fmt.Errorf("%w: wrong passphrase", ErrBadRequest)
+	}
+	var secrets configSecrets
+	if err := json.Unmarshal(plaintext, &secrets); err != nil {
+		return fmt.Errorf("unmarshalling secrets: %v", err)
+	}
+	config.ApiKey = secrets.ApiKey
+	config.ClientSecret = secrets.ClientSecret
+	config.AccessToken = secrets.AccessToken
+	config.RefreshToken = secrets.RefreshToken
+	config.ApiPassword = secrets.ApiPassword
+	return nil
+}
+
+func secretsCipher(passphrase string, salt []byte) (cipher.AEAD, error) {
+	key, err := pbkdf2.Key(sha256.New, passphrase, salt, keyIterations, secretsKeyLength)
+	if err != nil {
+		return nil, fmt.Errorf("deriving key: %v", err)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("creating cipher: %v", err)
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,151 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package conf
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/eliona-smart-building-assistant/go-utils/db"
+	"github.com/eliona-smart-building-assistant/go-utils/log"
+)
+
+// Migrations are named <version>_<name>.up.sql. Each must be safe to run on
+// installations that already have the change, as older app versions applied
+// some of them as patches.
+//
+//go:embed migrations/*.up.sql
+var migrationFiles embed.FS
+
+const schemaName = "abb_free_at_home"
+
+type migration struct {
+	version int
+	name    string
+	sql     string
+}
+
+// Migrate applies the pending embedded migrations in version order and records
+// them in the schema_migration table. All of them run in one transaction holding
+// an advisory lock, so concurrently starting instances wait for each other.
+func Migrate(ctx context.Context, conn db.Connection) error {
+	migrations, err := loadMigrations()
+	if err != nil {
+		return fmt.Errorf("loading migrations: %v", err)
+	}
+
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("starting transaction: %v", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, "select pg_advisory_xact_lock(hashtext($1))", schemaName+".schema_migration"); err != nil {
+		return fmt.Errorf("acquiring migration lock: %v", err)
+	}
+	if _, err := tx.Exec(ctx, `
+		create schema if not exists abb_free_at_home;
+		create table if not exists abb_free_at_home.schema_migration
+		(
+			version    integer primary key,
+			name       text not null,
+			applied_at timestamp with time zone not null default now()
+		);`); err != nil {
+		return fmt.Errorf("creating migration table: %v", err)
+	}
+
+	applied := make(map[int]bool)
+	rows, err := tx.Query(ctx, "select version from abb_free_at_home.schema_migration")
+	if err != nil {
+		return fmt.Errorf("reading applied migrations: %v", err)
+	}
+	for rows.Next() {
+		var version int
+		if err := rows.Scan(&version); err != nil {
+			rows.Close()
+			return fmt.Errorf("scanning applied migration: %v", err)
+		}
+		applied[version] = true
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("reading applied migrations: %v", err)
+	}
+
+	count := 0
+	for _, m := range migrations {
+		if applied[m.version] {
+			continue
+		}
+		log.Info("conf", "applying database migration %04d %s", m.version, m.name)
+		if _, err := tx.Exec(ctx, m.sql); err != nil {
+			return fmt.Errorf("applying migration %04d %s: %v", m.version, m.name, err)
+		}
+		if _, err := tx.Exec(ctx, "insert into abb_free_at_home.schema_migration (version, name) values ($1, $2)", m.version, m.name); err != nil {
+			return fmt.Errorf("recording migration %04d %s: %v", m.version, m.name, err)
+		}
+		count++
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("committing migrations: %v", err)
+	}
+	if count == 0 {
+		return nil
+	}
+	log.Info("conf", "applied %d database migrations", count)
+
+	// Objects created by the init user must be accessible to the app user.
+	if _, err := conn.Exec(ctx, fmt.Sprintf("select fixprivilege('%s','%s')", schemaName, db.Username())); err != nil {
+		log.Warn("conf", "cannot fix privileges for schema %s: %v", schemaName, err)
+	}
+	return nil
+}
+
+func loadMigrations() ([]migration, error) {
+	entries, err := migrationFiles.ReadDir("migrations")
+	if err != nil {
+		return nil, err
+	}
+	var migrations []migration
+	seen := make(map[int]string)
+	for _, entry := range entries {
+		base := strings.TrimSuffix(entry.Name(), ".up.sql")
+		versionPart, name, found := strings.Cut(base, "_")
+		if !found {
+			return nil, fmt.Errorf("migration %s is not named <version>_<name>.up.sql", entry.Name())
+		}
+		version, err := strconv.Atoi(versionPart)
+		if err != nil {
+			return nil, fmt.Errorf("parsing version of migration %s: %v", entry.Name(), err)
+		}
+		if other, ok := seen[version]; ok {
+			return nil, fmt.Errorf("migrations %s and %s have the same version", other, entry.Name())
+		}
+		seen[version] = entry.Name()
+		content, err := migrationFiles.ReadFile(path.Join("migrations", entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		migrations = append(migrations, migration{version: version, name: name, sql: string(content)})
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].version < migrations[j].version })
+	return migrations, nil
+}
@@ -0,0 +1,31 @@
+--  This file is part of the eliona project.
+--  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+--  ______ _ _
+-- |  ____| (_)
+-- | |__  | |_  ___  _ __   __ _
+-- |  __| | | |/ _ \| '_ \ / _` |
+-- | |____| | | (_) | | | | (_| |
+-- |______|_|_|\___/|_| |_|\__,_|
+--
+--  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+--  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+--  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+--  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+--  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+-- Gap in seconds without synchronization, after which the values changed in the meantime are backfilled.
+alter table abb_free_at_home.configuration add column if not exists backfill_threshold integer not null default 900;
+
+-- Last time the data of the configuration was synchronized to Eliona.
+create table if not exists abb_free_at_home.sync_watermark
+(
+	configuration_id bigint primary key references abb_free_at_home.configuration(id) ON DELETE CASCADE,
+	synced_at        timestamp with time zone not null
+);
+
+-- ABB timestamp of the last value of the datapoint written to Eliona by the backfill.
+create table if not exists abb_free_at_home.datapoint_watermark
+(
+	datapoint_id bigint primary key references abb_free_at_home.datapoint(id) ON DELETE CASCADE,
+	value_time   timestamp with time zone not null
+);
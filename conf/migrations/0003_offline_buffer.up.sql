@@ -0,0 +1,32 @@
+--  This file is part of the eliona project.
+--  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+--  ______ _ _
+-- |  ____| (_)
+-- | |__  | |_  ___  _ __   __ _
+-- |  __| | | |/ _ \| '_ \ / _` |
+-- | |____| | | (_) | | | | (_| |
+-- |______|_|_|\___/|_| |_|\__,_|
+--
+--  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+--  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+--  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+--  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+--  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+-- Maximum number of updates buffered per configuration while Eliona is unavailable.
+alter table abb_free_at_home.configuration add column if not exists buffer_size integer not null default 10000;
+-- Keep only the latest buffered value of each asset attribute.
+alter table abb_free_at_home.configuration add column if not exists buffer_compaction boolean not null default false;
+
+-- Updates that could not be delivered to Eliona, replayed in the order of their IDs.
+create table if not exists abb_free_at_home.buffered_data
+(
+	id               bigserial primary key,
+	configuration_id bigint not null references abb_free_at_home.configuration(id) ON DELETE CASCADE,
+	asset_id         integer not null,
+	asset_type_name  text not null,
+	subtype          text not null,
+	attribute_name   text not null,
+	value            jsonb not null,
+	value_time       timestamp with time zone not null
+);
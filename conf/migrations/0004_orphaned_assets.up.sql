@@ -0,0 +1,24 @@
+--  This file is part of the eliona project.
+--  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+--  ______ _ _
+-- |  ____| (_)
+-- | |__  | |_  ___  _ __   __ _
+-- |  __| | | |/ _ \| '_ \ / _` |
+-- | |____| | | (_) | | | | (_| |
+-- |______|_|_|\___/|_| |_|\__,_|
+--
+--  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+--  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+--  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+--  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+--  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+-- Seconds an entity must be missing at ABB before its asset is retired.
+alter table abb_free_at_home.configuration add column if not exists orphan_grace_period integer not null default 86400;
+-- What happens to retired assets: 'keep' leaves them in Eliona, 'delete' removes them.
+alter table abb_free_at_home.configuration add column if not exists orphan_policy text not null default 'keep';
+
+-- Since when the entity is no longer reported by ABB. Cleared when it reappears.
+alter table abb_free_at_home.asset add column if not exists orphaned_at timestamp with time zone;
+-- When the asset was retired after the grace period. Its datapoints are no longer subscribed.
+alter table abb_free_at_home.asset add column if not exists retired_at timestamp with time zone;
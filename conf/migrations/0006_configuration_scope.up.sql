@@ -0,0 +1,25 @@
+--  This file is part of the eliona project.
+--  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+--  ______ _ _
+-- |  ____| (_)
+-- | |__  | |_  ___  _ __   __ _
+-- |  __| | | |/ _ \| '_ \ / _` |
+-- | |____| | | (_) | | | | (_| |
+-- |______|_|_|\___/|_| |_|\__,_|
+--
+--  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+--  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+--  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+--  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+--  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+-- Prefix of the Eliona asset identifiers of the configuration, so that several configurations
+-- can share a project. Null means the default prefix derived from the configuration ID. The
+-- first existing configuration keeps unprefixed identifiers, so its assets stay the same.
+alter table abb_free_at_home.configuration add column if not exists gai_scope text;
+update abb_free_at_home.configuration set gai_scope = ''
+where gai_scope is null and id = (select min(id) from abb_free_at_home.configuration)
+	and not exists (select 1 from abb_free_at_home.configuration where gai_scope = '');
+
+-- Name of the root asset of the configuration.
+alter table abb_free_at_home.configuration add column if not exists root_asset_name text not null default 'ABB-free@home';
@@ -0,0 +1,41 @@
+--  This file is part of the eliona project.
+--  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+--  ______ _ _
+-- |  ____| (_)
+-- | |__  | |_  ___  _ __   __ _
+-- |  __| | | |/ _ \| '_ \ / _` |
+-- | |____| | | (_) | | | | (_| |
+-- |______|_|_|\___/|_| |_|\__,_|
+--
+--  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+--  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+--  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+--  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+--  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+-- Battery level and signal quality in percent below which an alert is raised for a device.
+alter table abb_free_at_home.configuration add column if not exists low_battery_threshold integer not null default 20;
+alter table abb_free_at_home.configuration add column if not exists weak_signal_threshold integer not null default 30;
+-- Percentage points above the threshold the value must recover to clear the alert.
+alter table abb_free_at_home.configuration add column if not exists alert_hysteresis integer not null default 5;
+-- How alerts are raised: 'off', 'notification' or 'alarm'.
+alter table abb_free_at_home.configuration add column if not exists alert_mode text not null default 'notification';
+
+-- Alerts currently raised for devices. An alert is cleared by deleting its row.
+create table if not exists abb_free_at_home.device_alert
+(
+	configuration_id bigint not null references abb_free_at_home.configuration(id) ON DELETE CASCADE,
+	device_gai       text not null,
+	kind             text not null,
+	raised_at        timestamp with time zone not null default now(),
+	primary key (configuration_id, device_gai, kind)
+);
+
+-- Eliona alarm rules created by the app for alert attributes.
+create table if not exists abb_free_at_home.alarm_rule
+(
+	asset_id      integer not null,
+	attribute     text not null,
+	alarm_rule_id integer not null,
+	primary key (asset_id, attribute)
+);
@@ -0,0 +1,32 @@
+--  This file is part of the eliona project.
+--  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+--  ______ _ _
+-- |  ____| (_)
+-- | |__  | |_  ___  _ __   __ _
+-- |  __| | | |/ _ \| '_ \ / _` |
+-- | |____| | | (_) | | | | (_| |
+-- |______|_|_|\___/|_| |_|\__,_|
+--
+--  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+--  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+--  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+--  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+--  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+-- Seconds a SysAP must be offline before the user is notified. 0 disables the notification.
+alter table abb_free_at_home.configuration add column if not exists offline_notification_threshold integer not null default 900;
+
+-- Every change of the connection state of a SysAP, used for the availability statistics.
+create table if not exists abb_free_at_home.system_connection_event
+(
+	id               bigserial primary key,
+	configuration_id bigint not null references abb_free_at_home.configuration(id) ON DELETE CASCADE,
+	system_id        text not null,
+	connected        boolean not null,
+	changed_at       timestamp with time zone not null default now(),
+	-- When the user was notified about this outage. Null if not (yet) notified.
+	notified_at      timestamp with time zone
+);
+
+create index if not exists system_connection_event_changed_at_idx
+	on abb_free_at_home.system_connection_event (configuration_id, system_id, changed_at);
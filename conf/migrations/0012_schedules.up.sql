@@ -0,0 +1,22 @@
+--  This file is part of the eliona project.
+--  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+--  ______ _ _
+-- |  ____| (_)
+-- | |__  | |_  ___  _ __   __ _
+-- |  __| | | |/ _ \| '_ \ / _` |
+-- | |____| | | (_) | | | | (_| |
+-- |______|_|_|\___/|_| |_|\__,_|
+--
+--  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+--  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+--  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+--  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+--  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+-- Discovery of assets runs on its own schedule, the refresh_interval only controls the value refresh.
+alter table abb_free_at_home.configuration add column if not exists discovery_interval integer not null default 3600;
+-- Cron expressions overriding the intervals. Empty if not used.
+alter table abb_free_at_home.configuration add column if not exists discovery_cron text not null default '';
+alter table abb_free_at_home.configuration add column if not exists refresh_cron text not null default '';
+-- Daily time window like '22:00-06:00' in which no discovery is started. Empty if not used.
+alter table abb_free_at_home.configuration add column if not exists discovery_quiet_hours text not null default '';
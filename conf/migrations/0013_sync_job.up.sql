@@ -0,0 +1,36 @@
+--  This file is part of the eliona project.
+--  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+--  ______ _ _
+-- |  ____| (_)
+-- | |__  | |_  ___  _ __   __ _
+-- |  __| | | |/ _ \| '_ \ / _` |
+-- | |____| | | (_) | | | | (_| |
+-- |______|_|_|\___/|_| |_|\__,_|
+--
+--  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+--  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+--  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+--  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+--  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+-- Synchronizations requested through the API, with their progress and results.
+create table if not exists abb_free_at_home.sync_job
+(
+	id               bigserial primary key,
+	configuration_id bigint not null references abb_free_at_home.configuration(id) ON DELETE CASCADE,
+	-- 'discovery', 'values' or 'reconcile'
+	mode             text not null,
+	-- 'pending', 'running', 'finished' or 'failed'
+	status           text not null default 'pending',
+	progress         integer not null default 0,
+	step             text not null default '',
+	assets_created   integer not null default 0,
+	assets_updated   integer not null default 0,
+	assets_orphaned  integer not null default 0,
+	assets_retired   integer not null default 0,
+	changes          jsonb not null default '[]',
+	errors           jsonb not null default '[]',
+	created_at       timestamp with time zone not null default now(),
+	started_at       timestamp with time zone,
+	finished_at      timestamp with time zone
+);
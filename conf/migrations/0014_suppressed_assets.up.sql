@@ -0,0 +1,22 @@
+--  This file is part of the eliona project.
+--  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+--  ______ _ _
+-- |  ____| (_)
+-- | |__  | |_  ___  _ __   __ _
+-- |  __| | | |/ _ \| '_ \ / _` |
+-- | |____| | | (_) | | | | (_| |
+-- |______|_|_|\___/|_| |_|\__,_|
+--
+--  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+--  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+--  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+--  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+--  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+-- Assets deleted in Eliona that the user chose to forget. They are not created again.
+alter table abb_free_at_home.asset add column if not exists forgotten_at timestamp with time zone;
+
+-- Re-created assets get a new Eliona ID, which their datapoints follow.
+alter table abb_free_at_home.datapoint drop constraint if exists datapoint_asset_id_fkey;
+alter table abb_free_at_home.datapoint add constraint datapoint_asset_id_fkey
+	foreign key (asset_id) references abb_free_at_home.asset(asset_id) on delete cascade on update cascade;
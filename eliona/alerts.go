@@ -0,0 +1,205 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package eliona
+
+import (
+	"abb-free-at-home/apiserver"
+	"abb-free-at-home/appdb"
+	"abb-free-at-home/conf"
+	"abb-free-at-home/model"
+	"context"
+	"fmt"
+	"strings"
+
+	api "github.com/eliona-smart-building-assistant/go-eliona-api-client/v2"
+	"github.com/eliona-smart-building-assistant/go-eliona/client"
+	"github.com/eliona-smart-building-assistant/go-utils/common"
+	"github.com/eliona-smart-building-assistant/go-utils/log"
+)
+
+// Kinds of device alerts. They equal the names of the device attributes holding the alert state.
+const (
+	alertLowBattery = "low_battery"
+	alertWeakSignal = "weak_signal"
+)
+
+// raisedAlert is a device alert raised in the current evaluation.
+type raisedAlert struct {
+	kind     string
+	systemID string
+	device   model.Device
+}
+
+// EvaluateDeviceAlerts applies the battery and signal quality thresholds of the configuration to
+// the devices. An alert is raised when a value drops below its threshold and cleared when it
+// recovers to the threshold plus the hysteresis. The alert states are set on the devices, so that
+// they are pushed to Eliona with the other data. Newly raised alerts are reported as configured
+// by the alert mode.
+func EvaluateDeviceAlerts(config apiserver.Configuration, systems []model.System) error {
+	ctx := context.Background()
+	mode := common.Val(config.AlertMode)
+	alerts, err := conf.GetDeviceAlerts(ctx, config)
+	if err != nil {
+		return fmt.Errorf("fetching device alerts: %v", err)
+	}
+	active := make(map[string]*appdb.DeviceAlert, len(alerts))
+	for _, alert := range alerts {
+		active[alert.DeviceGai+"/"+alert.Kind] = alert
+	}
+	hysteresis := int64(common.Val(config.AlertHysteresis))
+
+	var raised []raisedAlert
+	for si := range systems {
+		for di := range systems[si].Devices {
+			device := &systems[si].Devices[di]
+			for _, check := range []struct {
+				kind      string
+				value     *int64
+				threshold int64
+				state     *int8
+			}{
+				{alertLowBattery, device.Battery, int64(common.Val(config.LowBatteryThreshold)), &device.LowBattery},
+				{alertWeakSignal, device.SignalQuality, int64(common.Val(config.WeakSignalThreshold)), &device.WeakSignal},
+			} {
+				alert := active[device.GAI+"/"+check.kind]
+				isActive := alert != nil
+				switch {
+				case isActive && mode == conf.ALERT_MODE_OFF:
+					isActive = false
+				case check.value == nil:
+					// Unknown value, keep the state.
+				case !isActive && *check.value < check.threshold:
+					log.Info("Eliona", "raising %s alert for device '%s': %d %%", check.kind, device.GAI, *check.value)
+					if err := conf.RaiseDeviceAlert(ctx, config, device.GAI, check.kind); err != nil {
+						return fmt.Errorf("raising %s alert for device '%s': %v", check.kind, device.GAI, err)
+					}
+					isActive = true
+					raised = append(raised, raisedAlert{check.kind, systems[si].ID, *device})
+				case isActive && *check.value >= check.threshold+hysteresis:
+					isActive = false
+				}
+				if alert != nil && !isActive {
+					log.Info("Eliona", "clearing %s alert for device '%s'", check.kind, device.GAI)
+					if err := conf.ClearDeviceAlert(ctx, alert); err != nil {
+						return fmt.Errorf("clearing %s alert for device '%s': %v", check.kind, device.GAI, err)
+					}
+				}
+				if isActive {
+					*check.state = 1
+				}
+			}
+		}
+	}
+	if len(raised) == 0 {
+		return nil
+	}
+	switch mode {
+	case conf.ALERT_MODE_NOTIFICATION:
+		return notifyUserAboutAlerts(config, raised)
+	case conf.ALERT_MODE_ALARM:
+		return ensureAlarmRules(config, raised)
+	}
+	return nil
+}
+
+func notifyUserAboutAlerts(config apiserver.Configuration, raised []raisedAlert) error {
+	if config.UserId == nil {
+		return nil
+	}
+	for _, projectId := range conf.AllProjIds(config) {
+		var lowBattery, weakSignal []string
+		for _, r := range raised {
+			if !conf.IsSystemInProject(config, r.systemID, projectId) {
+				continue
+			}
+			switch r.kind {
+			case alertLowBattery:
+				lowBattery = append(lowBattery, r.device.Name)
+			case alertWeakSignal:
+				weakSignal = append(weakSignal, r.device.Name)
+			}
+		}
+		if len(lowBattery) > 0 {
+			if err := postNotification(*config.UserId, projectId, api.Translation{
+				De: api.PtrString(fmt.Sprintf("ABB-Free@home App: Batterie schwach bei %s.", strings.Join(lowBattery, ", "))),
+				En: api.PtrString(fmt.Sprintf("ABB-Free@home app: Low battery of %s.", strings.Join(lowBattery, ", "))),
+			}); err != nil {
+				return err
+			}
+		}
+		if len(weakSignal) > 0 {
+			if err := postNotification(*config.UserId, projectId, api.Translation{
+				De: api.PtrString(fmt.Sprintf("ABB-Free@home App: Schwaches Funksignal bei %s.", strings.Join(weakSignal, ", "))),
+				En: api.PtrString(fmt.Sprintf("ABB-Free@home app: Weak radio signal of %s.", strings.Join(weakSignal, ", "))),
+			}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ensureAlarmRules creates Eliona alarm rules on the alert attributes of the devices, so that
+// Eliona raises an alarm when the alert state is pushed. Each rule is created only once; rules
+// deleted by the user are not created again.
+func ensureAlarmRules(config apiserver.Configuration, raised []raisedAlert) error {
+	ctx := context.Background()
+	for _, r := range raised {
+		for _, projectId := range conf.SystemProjIds(config, r.systemID) {
+			assetID, err := conf.GetAssetId(ctx, config, projectId, fmt.Sprintf("%s_%s", r.device.AssetType(), r.device.GAI))
+			if err != nil {
+				return fmt.Errorf("fetching asset of device '%s': %v", r.device.GAI, err)
+			}
+			if assetID == nil {
+				continue // Not created yet.
+			}
+			ruleID, err := conf.GetAlarmRuleID(ctx, *assetID, r.kind)
+			if err != nil {
+				return fmt.Errorf("fetching alarm rule of asset %d: %v", *assetID, err)
+			}
+			if ruleID != nil {
+				continue
+			}
+			rule := api.NewAlarmRule(*assetID, api.SUBTYPE_STATUS, r.kind, api.ALARM_PRIORITY_LOW)
+			rule.Equal = *api.NewNullableFloat64(common.Ptr(1.0))
+			rule.Message = alarmMessage(r.kind)
+			created, _, err := client.NewClient().AlarmRulesAPI.
+				PostAlarmRule(client.AuthenticationContext()).
+				AlarmRule(*rule).
+				Execute()
+			if err != nil {
+				return fmt.Errorf("creating alarm rule for asset %d: %v", *assetID, err)
+			}
+			if err := conf.SetAlarmRuleID(ctx, *assetID, r.kind, created.GetId()); err != nil {
+				return fmt.Errorf("storing alarm rule of asset %d: %v", *assetID, err)
+			}
+		}
+	}
+	return nil
+}
+
+func alarmMessage(kind string) map[string]interface{} {
+	if kind == alertLowBattery {
+		return map[string]interface{}{
+			"de": "Batterie schwach",
+			"en": "Low battery",
+		}
+	}
+	return map[string]interface{}{
+		"de": "Schwaches Funksignal",
+		"en": "Weak radio signal",
+	}
+}
@@ -27,20 +27,40 @@ import (
 	"github.com/eliona-smart-building-assistant/go-eliona/client"
 	"github.com/eliona-smart-building-assistant/go-utils/common"
 	"github.com/eliona-smart-building-assistant/go-utils/log"
+	"github.com/volatiletech/null/v8"
 )
 
+// SyncStats counts the asset changes made by a synchronization.
+type SyncStats struct {
+	Created  int
+	Updated  int // Renamed or moved
+	Orphaned int // Newly marked as orphaned
+	Retired  int
+}
+
 type Asset interface {
 	AssetType() string
 	Id() string
 }
 
-func CreateLocationAssetsIfNecessary(config apiserver.Configuration, locations []model.Floor) error {
-	for _, projectId := range conf.ProjIds(config) {
-		rootAssetID, err := upsertRootAsset(config, projectId)
+// CreateLocationAssetsIfNecessary creates or updates the floor and room assets. The changes are
+// counted in stats, which may be nil.
+func CreateLocationAssetsIfNecessary(config apiserver.Configuration, locations []model.Floor, stats *SyncStats) error {
+	return createLocationAssets(config, locations, stats, nil)
+}
+
+// createLocationAssets only collects the changes in preview if it is set.
+func createLocationAssets(config apiserver.Configuration, locations []model.Floor, stats *SyncStats, preview *assetPreview) error {
+	for _, projectId := range conf.AllProjIds(config) {
+		report := &changeReport{}
+		if preview != nil {
+			report = nil
+		}
+		rootAssetID, err := upsertRootAsset(config, projectId, preview)
 		if err != nil {
 			return fmt.Errorf("upserting root asset: %v", err)
 		}
-		for _, floor := range locations {
+		for _, floor := range locationsInProject(config, locations, projectId) {
 			assetType := "abb_free_at_home_floor"
 			_, floorAssetID, err := upsertAsset(assetData{
 				config:                  config,
@@ -52,6 +72,9 @@ func CreateLocationAssetsIfNecessary(config apiserver.Configuration, locations [
 				assetType:               assetType,
 				name:                    floor.Name,
 				description:             fmt.Sprintf("%s (%v)", floor.Name, floor.GAI()),
+				report:                  report,
+				stats:                   stats,
+				preview:                 preview,
 			})
 			if err != nil {
 				return fmt.Errorf("upserting floor %s: %v", floor.GAI(), err)
@@ -68,24 +91,43 @@ func CreateLocationAssetsIfNecessary(config apiserver.Configuration, locations [
 					assetType:               assetType,
 					name:                    room.Name,
 					description:             fmt.Sprintf("%s (%v)", room.Name, room.GAI()),
+					report:                  report,
+					stats:                   stats,
+					preview:                 preview,
 				})
 				if err != nil {
 					return fmt.Errorf("upserting room %s: %v", room.GAI(), err)
 				}
 			}
 		}
+		report.log(config, projectId)
 	}
 	return nil
 }
 
-func CreateAssetsIfNecessary(config apiserver.Configuration, systems []model.System) error {
-	for _, projectId := range conf.ProjIds(config) {
+// CreateAssetsIfNecessary creates or updates the system, device and channel assets. The changes
+// are counted in stats, which may be nil.
+func CreateAssetsIfNecessary(config apiserver.Configuration, locations []model.Floor, systems []model.System, stats *SyncStats) error {
+	return createAssets(config, locations, systems, stats, nil)
+}
+
+// createAssets only collects the changes in preview if it is set.
+func createAssets(config apiserver.Configuration, locations []model.Floor, systems []model.System, stats *SyncStats, preview *assetPreview) error {
+	datapoints, err := conf.GetDatapointsByAsset(context.Background(), config)
+	if err != nil {
+		return fmt.Errorf("fetching datapoints: %v", err)
+	}
+	for _, projectId := range conf.AllProjIds(config) {
 		assetsCreated := 0
-		rootAssetID, err := upsertRootAsset(config, projectId)
+		report := &changeReport{}
+		if preview != nil {
+			report = nil
+		}
+		rootAssetID, err := upsertRootAsset(config, projectId, preview)
 		if err != nil {
 			return fmt.Errorf("upserting root asset: %v", err)
 		}
-		for _, system := range systems {
+		for _, system := range systemsInProject(config, systems, projectId) {
 			if len(system.Devices) == 0 {
 				continue
 			}
@@ -100,6 +142,9 @@ func CreateAssetsIfNecessary(config apiserver.Configuration, systems []model.Sys
 				assetType:               assetType,
 				name:                    system.Name,
 				description:             fmt.Sprintf("%s (%v)", system.Name, system.GAI),
+				report:                  report,
+				stats:                   stats,
+				preview:                 preview,
 			})
 			if err != nil {
 				return fmt.Errorf("upserting system %s: %v", system.GAI, err)
@@ -111,13 +156,11 @@ func CreateAssetsIfNecessary(config apiserver.Configuration, systems []model.Sys
 				if len(device.Channels) == 0 {
 					continue
 				}
-				locParentId := lookupLocationParent(config, projectId, device.Location)
-				deviceNamePrefix := ""
-				if locParentId != nil {
-					deviceNamePrefix = constructDeviceNamePrefix(locParentId)
-				} else {
+				locParentId := lookupLocationParent(config, projectId, device.Location, preview)
+				if locParentId == nil {
 					locParentId = &systemAssetID
 				}
+				parts := deviceNameParts(system, device, locations)
 				assetType := "abb_free_at_home_device"
 				ad := assetData{
 					config:                  config,
@@ -127,8 +170,11 @@ func CreateAssetsIfNecessary(config apiserver.Configuration, systems []model.Sys
 					identifier:              fmt.Sprintf("%s_%s", assetType, device.GAI),
 					providerID:              device.ID,
 					assetType:               assetType,
-					name:                    fmt.Sprintf("%s | %s", deviceNamePrefix, device.Name),
-					description:             fmt.Sprintf("%s (%v)", device.Name, device.GAI),
+					name:                    deviceName(config, parts),
+					description:             deviceDescription(config, parts),
+					report:                  report,
+					stats:                   stats,
+					preview:                 preview,
 				}
 
 				created, deviceAssetID, err := upsertAsset(ad)
@@ -139,6 +185,7 @@ func CreateAssetsIfNecessary(config apiserver.Configuration, systems []model.Sys
 					assetsCreated++
 				}
 				for _, channel := range device.Channels {
+					channelParts := parts.withChannel(channel)
 					created, channelAssetID, err := upsertAsset(assetData{
 						config:                  config,
 						projectId:               projectId,
@@ -147,38 +194,31 @@ func CreateAssetsIfNecessary(config apiserver.Configuration, systems []model.Sys
 						identifier:              channel.GAI(),
 						providerID:              channel.Id(),
 						assetType:               channel.AssetType(),
-						name:                    fmt.Sprintf("%s | %s", deviceNamePrefix, channel.Name()),
-						description:             fmt.Sprintf("%s (%v)", channel.Name(), channel.GAI()),
+						name:                    channelName(config, channelParts),
+						description:             channelDescription(config, channelParts),
+						report:                  report,
+						stats:                   stats,
+						preview:                 preview,
 					})
 					if err != nil {
 						return fmt.Errorf("upserting channel %s: %v", channel.GAI(), err)
 					}
+					channelReport := report
 					if created {
 						assetsCreated++
+						channelReport = nil
 					}
-					if created {
-						for function, datapoint := range channel.Inputs() {
-							_, err := conf.InsertInput(channelAssetID, system.ID, device.ID, channel.Id(), datapoint, function)
-							if err != nil {
-								return fmt.Errorf("inserting input: %v", err)
-							}
-						}
-						for function, datapoint := range channel.Outputs() {
-							dpId, err := conf.InsertOutput(channelAssetID, system.ID, device.ID, channel.Id(), datapoint.Name, function)
-							if err != nil {
-								return fmt.Errorf("inserting output: %v", err)
-							}
-							for _, attr := range datapoint.Map {
-								if err := conf.LinkDatapointToAttribute(dpId, string(attr.Subtype), attr.AttributeName); err != nil {
-									return fmt.Errorf("inserting datapoint-attribute link: %v", err)
-								}
-							}
-						}
+					if preview != nil {
+						continue
+					}
+					if err := reconcileDatapoints(datapoints[channelAssetID], channelAssetID, system.ID, device.ID, channel, channelReport); err != nil {
+						return fmt.Errorf("reconciling datapoints of channel %s: %v", channel.GAI(), err)
 					}
 				}
 			}
 		}
-		if assetsCreated > 0 {
+		report.log(config, projectId)
+		if assetsCreated > 0 && preview == nil {
 			if err := notifyUser(*config.UserId, projectId, assetsCreated); err != nil {
 				return fmt.Errorf("notifying users about CAC: %v", err)
 			}
@@ -187,7 +227,32 @@ func CreateAssetsIfNecessary(config apiserver.Configuration, systems []model.Sys
 	return nil
 }
 
-func lookupLocationParent(config apiserver.Configuration, projectId string, locationId string) *int32 {
+// systemsInProject returns the systems that are created in the project.
+func systemsInProject(config apiserver.Configuration, systems []model.System, projectId string) []model.System {
+	var inProject []model.System
+	for _, system := range systems {
+		if conf.IsSystemInProject(config, system.ID, projectId) {
+			inProject = append(inProject, system)
+		}
+	}
+	return inProject
+}
+
+// locationsInProject returns the floors of the systems that are created in the project.
+func locationsInProject(config apiserver.Configuration, locations []model.Floor, projectId string) []model.Floor {
+	var inProject []model.Floor
+	for _, floor := range locations {
+		if conf.IsSystemInProject(config, floor.SystemID, projectId) {
+			inProject = append(inProject, floor)
+		}
+	}
+	return inProject
+}
+
+func lookupLocationParent(config apiserver.Configuration, projectId string, locationId string, preview *assetPreview) *int32 {
+	if id, ok := preview.placeholderID(projectId, "abb_free_at_home_room_"+locationId); ok {
+		return &id
+	}
 	parentId, err := conf.GetAssetId(context.Background(), config, projectId, "abb_free_at_home_room_"+locationId)
 	if err != nil {
 		log.Debug("conf", "looking up asset location parent %v: %v", "abb_free_at_home_room_"+locationId, err)
@@ -197,39 +262,25 @@ func lookupLocationParent(config apiserver.Configuration, projectId string, loca
 	return parentId
 }
 
-func constructDeviceNamePrefix(roomId *int32) string {
-	prefix := ""
-	room, _, err := client.NewClient().AssetsAPI.
-		GetAssetById(client.AuthenticationContext(), *roomId).
-		Execute()
-	if err != nil {
-		log.Debug("client", "getting room %v from Eliona: %v", *roomId, err)
-		return prefix
-	}
-	prefix = fmt.Sprintf(room.GetName())
-	floorId := room.GetParentLocationalAssetId()
-	if floorId == 0 {
-		return prefix
-	}
-	floor, _, err := client.NewClient().AssetsAPI.
-		GetAssetById(client.AuthenticationContext(), floorId).
-		Execute()
-	if err != nil {
-		log.Debug("client", "getting floor %v from Eliona: %v", floorId, err)
-		return prefix
+const rootAssetType = "abb_free_at_home_root"
+
+func rootAssetName(config apiserver.Configuration) string {
+	if config.RootAssetName == nil || *config.RootAssetName == "" {
+		return "ABB-free@home"
 	}
-	return fmt.Sprintf("%s | %s", floor.GetName(), prefix)
+	return *config.RootAssetName
 }
 
-func upsertRootAsset(config apiserver.Configuration, projectId string) (int32, error) {
+func upsertRootAsset(config apiserver.Configuration, projectId string, preview *assetPreview) (int32, error) {
 	_, rootAssetID, err := upsertAsset(assetData{
 		config:                  config,
 		projectId:               projectId,
 		parentLocationalAssetId: nil,
-		identifier:              "abb_free_at_home_root",
-		assetType:               "abb_free_at_home_root",
-		name:                    "ABB-free@home",
+		identifier:              rootAssetType,
+		assetType:               rootAssetType,
+		name:                    rootAssetName(config),
 		description:             "Root asset for ABB-free@home devices",
+		preview:                 preview,
 	})
 	return rootAssetID, err
 }
@@ -244,29 +295,55 @@ type assetData struct {
 	assetType               string
 	name                    string
 	description             string
+	report                  *changeReport // Collects renames and moves of existing assets.
+	stats                   *SyncStats
+	preview                 *assetPreview // If set, changes are only collected, nothing is written.
 }
 
 func upsertAsset(d assetData) (created bool, assetID int32, err error) {
-	// Get known asset id from configuration
-	currentAssetID, err := conf.GetAssetId(context.Background(), d.config, d.projectId, d.identifier)
+	// Get known asset from configuration
+	current, err := conf.GetAsset(context.Background(), d.config, d.projectId, d.identifier)
 	if err != nil {
-		return false, 0, fmt.Errorf("finding asset ID: %v", err)
+		return false, 0, fmt.Errorf("finding asset: %v", err)
+	}
+	if current != nil && current.ForgottenAt.Valid {
+		// Deleted in Eliona and forgotten by the user.
+		return false, current.AssetID.Int32, nil
 	}
-	existedInApp := currentAssetID != nil
+	existedInApp := current != nil && current.AssetID.Valid
 	if existedInApp {
-		existsInEliona, err := asset.ExistAsset(*currentAssetID)
+		existsInEliona, err := asset.ExistAsset(current.AssetID.Int32)
 		if err != nil {
 			return false, 0, fmt.Errorf("looking up assset in Eliona: %v", err)
 		}
 		if !existsInEliona {
-			// Exists in app, not in Eliona -> it was deleted from Eliona. Ignore.
-			return false, *currentAssetID, nil
+			// Exists in app, not in Eliona -> it was deleted from Eliona. Ignore until it is
+			// re-created or forgotten through the API.
+			return false, current.AssetID.Int32, nil
 		}
+		// Name is unknown for assets stored by older app versions.
+		if current.Name.Valid {
+			renamed := current.Name.String != d.name
+			if renamed {
+				d.report.add("%s: renamed from '%s' to '%s'", d.identifier, current.Name.String, d.name)
+			}
+			moved := current.LocationalParentID != null.Int32FromPtr(d.parentLocationalAssetId)
+			if moved {
+				d.report.add("%s: moved from location %s to %s", d.identifier, formatAssetID(current.LocationalParentID.Ptr()), formatAssetID(d.parentLocationalAssetId))
+			}
+			if (renamed || moved) && d.stats != nil {
+				d.stats.Updated++
+			}
+		}
+	}
+
+	if d.preview != nil {
+		return d.preview.record(d, current, existedInApp)
 	}
 
 	a := api.Asset{
 		ProjectId:               d.projectId,
-		GlobalAssetIdentifier:   d.identifier,
+		GlobalAssetIdentifier:   conf.ElionaGAI(d.config, d.identifier),
 		Name:                    *api.NewNullableString(common.Ptr(d.name)),
 		AssetType:               d.assetType,
 		Description:             *api.NewNullableString(common.Ptr(d.description)),
@@ -274,6 +351,11 @@ func upsertAsset(d assetData) (created bool, assetID int32, err error) {
 		ParentLocationalAssetId: *api.NewNullableInt32(d.parentLocationalAssetId),
 		IsTracker:               *api.NewNullableBool(common.Ptr(false)),
 	}
+	if existedInApp {
+		// Update the known asset even if its identifier changed, e.g. when the configuration
+		// got a gaiScope on upgrade, instead of creating a duplicate.
+		a.Id = *api.NewNullableInt32(common.Ptr(current.AssetID.Int32))
+	}
 	newID, err := asset.UpsertAsset(a)
 	if err != nil {
 		return false, 0, fmt.Errorf("upserting asset %+v into Eliona: %v", a, err)
@@ -281,33 +363,64 @@ func upsertAsset(d assetData) (created bool, assetID int32, err error) {
 	if newID == nil {
 		return false, 0, fmt.Errorf("cannot create asset %s", d.name)
 	}
-	if err := conf.UpsertAsset(context.Background(), d.config, d.projectId, d.identifier, d.assetType, d.providerID, *newID); err != nil {
+	if err := conf.UpsertAsset(context.Background(), d.config, d.projectId, d.identifier, d.assetType, d.providerID, d.name, d.parentLocationalAssetId, *newID); err != nil {
 		return false, 0, fmt.Errorf("inserting asset to config db: %v", err)
 	}
 
 	if existedInApp {
 		return false, *newID, nil
 	}
+	if d.stats != nil {
+		d.stats.Created++
+	}
 	log.Debug("eliona", "Created new asset for project %s and device %s.", d.projectId, d.identifier)
 	return true, *newID, nil
 }
 
+func formatAssetID(id *int32) string {
+	if id == nil {
+		return "none"
+	}
+	return fmt.Sprint(*id)
+}
+
+// AssetIDsByGAI returns the IDs of all Eliona assets in the project keyed by their global asset identifier.
+func AssetIDsByGAI(projectId string) (map[string]int32, error) {
+	assets, _, err := client.NewClient().AssetsAPI.
+		GetAssets(client.AuthenticationContext()).
+		ProjectId(projectId).
+		Execute()
+	if err != nil {
+		return nil, fmt.Errorf("fetching assets: %v", err)
+	}
+	ids := make(map[string]int32, len(assets))
+	for _, a := range assets {
+		ids[a.GlobalAssetIdentifier] = a.GetId()
+	}
+	return ids, nil
+}
+
 func notifyUser(userId string, projectId string, assetsCreated int) error {
+	return postNotification(userId, projectId, api.Translation{
+		De: api.PtrString(fmt.Sprintf("ABB-Free@home App hat %d neue Assets angelegt. Diese sind nun im Asset-Management verfügbar.", assetsCreated)),
+		En: api.PtrString(fmt.Sprintf("ABB-Free@home app added %v new assets. They are now available in Asset Management.", assetsCreated)),
+	})
+}
+
+// postNotification sends the message to the user in the project.
+func postNotification(userId string, projectId string, message api.Translation) error {
 	receipt, _, err := client.NewClient().CommunicationAPI.
 		PostNotification(client.AuthenticationContext()).
 		Notification(
 			api.Notification{
 				User:      userId,
 				ProjectId: *api.NewNullableString(&projectId),
-				Message: *api.NewNullableTranslation(&api.Translation{
-					De: api.PtrString(fmt.Sprintf("ABB-Free@home App hat %d neue Assets angelegt. Diese sind nun im Asset-Management verfügbar.", assetsCreated)),
-					En: api.PtrString(fmt.Sprintf("ABB-Free@home app added %v new assets. They are now available in Asset Management.", assetsCreated)),
-				}),
+				Message:   *api.NewNullableTranslation(&message),
 			}).
 		Execute()
-	log.Debug("eliona", "posted notification about CAC: %v", receipt)
+	log.Debug("eliona", "posted notification: %v", receipt)
 	if err != nil {
-		return fmt.Errorf("posting CAC notification: %v", err)
+		return fmt.Errorf("posting notification: %v", err)
 	}
 	return nil
 }
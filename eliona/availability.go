@@ -0,0 +1,74 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package eliona
+
+import (
+	"abb-free-at-home/apiserver"
+	"abb-free-at-home/conf"
+	"context"
+	"fmt"
+	"time"
+
+	api "github.com/eliona-smart-building-assistant/go-eliona-api-client/v2"
+	"github.com/eliona-smart-building-assistant/go-utils/common"
+)
+
+// NotifyLongOutages notifies the user once about each SysAP that is offline for longer than the
+// configured threshold.
+func NotifyLongOutages(config apiserver.Configuration) error {
+	threshold := common.Val(config.OfflineNotificationThreshold)
+	if threshold <= 0 || config.UserId == nil {
+		return nil
+	}
+	ctx := context.Background()
+	outages, err := conf.GetPendingOutageNotifications(ctx, config, time.Now().Add(-time.Duration(threshold)*time.Second))
+	if err != nil {
+		return fmt.Errorf("fetching pending outages: %v", err)
+	}
+	if len(outages) == 0 {
+		return nil
+	}
+	systems, err := conf.GetSystems(ctx, config)
+	if err != nil {
+		return fmt.Errorf("fetching systems: %v", err)
+	}
+	for _, outage := range outages {
+		name := outage.SystemID
+		for _, system := range systems {
+			if system.ProviderID == outage.SystemID && system.Name.Valid {
+				name = system.Name.String
+				break
+			}
+		}
+		for _, projectId := range conf.SystemProjIds(config, outage.SystemID) {
+			if err := notifyUserAboutOutage(*config.UserId, projectId, name, outage.ChangedAt); err != nil {
+				return err
+			}
+		}
+		if err := conf.MarkOutageNotified(ctx, outage, time.Now()); err != nil {
+			return fmt.Errorf("marking outage of system '%s' notified: %v", outage.SystemID, err)
+		}
+	}
+	return nil
+}
+
+func notifyUserAboutOutage(userId string, projectId string, systemName string, since time.Time) error {
+	message := api.Translation{
+		De: api.PtrString(fmt.Sprintf("ABB-Free@home App: SysAP %s ist seit %s offline.", systemName, since.Format(time.DateTime))),
+		En: api.PtrString(fmt.Sprintf("ABB-Free@home app: SysAP %s is offline since %s.", systemName, since.Format(time.DateTime))),
+	}
+	return postNotification(userId, projectId, message)
+}
@@ -0,0 +1,79 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package eliona
+
+import (
+	"abb-free-at-home/apiserver"
+	"abb-free-at-home/appdb"
+	"abb-free-at-home/conf"
+	"abb-free-at-home/model"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/eliona-smart-building-assistant/go-utils/log"
+)
+
+type datapointKey struct {
+	deviceID  string
+	channelID string
+	datapoint string
+}
+
+// BackfillSystemsData writes the values that changed at ABB after the given time
+// to Eliona with their original timestamps, filling the gap left by an outage.
+// ABB reports only the last change of each datapoint, so intermediate values
+// are lost; at most one value per datapoint is recovered. Returns the number of
+// values written.
+func BackfillSystemsData(config apiserver.Configuration, systems []model.System, since time.Time) (int, error) {
+	dbDatapoints, err := conf.GetOutputDatapoints(context.Background(), config)
+	if err != nil {
+		return 0, fmt.Errorf("fetching output datapoints: %v", err)
+	}
+	datapoints := make(map[datapointKey]*appdb.Datapoint, len(dbDatapoints))
+	for _, dp := range dbDatapoints {
+		datapoints[datapointKey{dp.DeviceID, dp.ChannelID, dp.Datapoint}] = dp
+	}
+
+	written := 0
+	for _, system := range systems {
+		for _, device := range system.Devices {
+			for _, channel := range device.Channels {
+				for _, datapoint := range channel.Outputs() {
+					if !datapoint.Time.After(since) {
+						continue
+					}
+					dbDatapoint, ok := datapoints[datapointKey{device.ID, channel.Id(), datapoint.Name}]
+					if !ok {
+						log.Debug("Eliona", "no datapoint stored for %s/%s/%s, skipping backfill", device.ID, channel.Id(), datapoint.Name)
+						continue
+					}
+					if watermark := dbDatapoint.R.GetDatapointWatermark(); watermark != nil && !datapoint.Time.After(watermark.ValueTime) {
+						continue
+					}
+					if err := UpsertDatapointData(config, *dbDatapoint, datapoint.Value, &datapoint.Time); err != nil {
+						return written, fmt.Errorf("backfilling datapoint %s/%s/%s: %v", device.ID, channel.Id(), datapoint.Name, err)
+					}
+					if err := conf.SetDatapointWatermark(context.Background(), dbDatapoint.ID, datapoint.Time); err != nil {
+						return written, fmt.Errorf("setting watermark for datapoint %d: %v", dbDatapoint.ID, err)
+					}
+					written++
+				}
+			}
+		}
+	}
+	return written, nil
+}
@@ -0,0 +1,151 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package eliona
+
+import (
+	"abb-free-at-home/apiserver"
+	"abb-free-at-home/appdb"
+	"abb-free-at-home/conf"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	api "github.com/eliona-smart-building-assistant/go-eliona-api-client/v2"
+	"github.com/eliona-smart-building-assistant/go-eliona/asset"
+	"github.com/eliona-smart-building-assistant/go-utils/common"
+	"github.com/eliona-smart-building-assistant/go-utils/log"
+)
+
+const replayBatchSize = 100
+
+// replayMu prevents the periodic collection and the replay loop from replaying the same updates twice.
+var replayMu sync.Mutex
+
+// deliverOrBuffer upserts the data to Eliona. If Eliona is unavailable, or older
+// updates are still waiting for replay, the data is buffered to keep the order.
+func deliverOrBuffer(config apiserver.Configuration, data api.Data) error {
+	pending, err := conf.BufferedDataExists(context.Background(), config)
+	if err != nil {
+		return fmt.Errorf("checking buffered data: %v", err)
+	}
+	if !pending {
+		err := asset.UpsertDataIfAssetExists(data)
+		if err == nil || !isUnavailable(err) {
+			return err
+		}
+		log.Warn("Eliona", "Eliona unavailable, buffering data for asset %d: %v", data.AssetId, err)
+	}
+	return bufferData(config, data)
+}
+
+func bufferData(config apiserver.Configuration, data api.Data) error {
+	timestamp := time.Now()
+	if t := data.Timestamp.Get(); t != nil {
+		timestamp = *t
+	}
+	for attributeName, value := range data.Data {
+		v, err := json.Marshal(value)
+		if err != nil {
+			return fmt.Errorf("marshalling value of %s: %v", attributeName, err)
+		}
+		dropped, err := conf.BufferData(context.Background(), config, appdb.BufferedDatum{
+			AssetID:       data.AssetId,
+			AssetTypeName: data.GetAssetTypeName(),
+			Subtype:       string(data.Subtype),
+			AttributeName: attributeName,
+			Value:         v,
+			ValueTime:     timestamp,
+		})
+		if err != nil {
+			return fmt.Errorf("buffering data: %v", err)
+		}
+		if dropped > 0 {
+			log.Warn("Eliona", "buffer of config %d is full, dropped %d oldest updates", *config.Id, dropped)
+		}
+	}
+	return nil
+}
+
+// ReplayBufferedData delivers the buffered updates of the configuration to Eliona
+// in the order they were buffered. Returns true if updates are still waiting,
+// e.g. because Eliona is still unavailable.
+func ReplayBufferedData(config apiserver.Configuration) (bool, error) {
+	replayMu.Lock()
+	defer replayMu.Unlock()
+	for {
+		buffered, err := conf.GetBufferedData(context.Background(), config, replayBatchSize)
+		if err != nil {
+			return true, fmt.Errorf("fetching buffered data: %v", err)
+		}
+		if len(buffered) == 0 {
+			return false, nil
+		}
+		var done []int64
+		var unavailableErr error
+		for _, b := range buffered {
+			var value any
+			if err := json.Unmarshal(b.Value, &value); err != nil {
+				log.Error("Eliona", "dropping buffered data %d with invalid value: %v", b.ID, err)
+				done = append(done, b.ID)
+				continue
+			}
+			data := api.Data{
+				AssetId:         b.AssetID,
+				Subtype:         api.DataSubtype(b.Subtype),
+				Timestamp:       *api.NewNullableTime(&b.ValueTime),
+				Data:            map[string]interface{}{b.AttributeName: value},
+				AssetTypeName:   *api.NewNullableString(common.Ptr(b.AssetTypeName)),
+				ClientReference: *api.NewNullableString(common.Ptr(ClientReference)),
+			}
+			if err := asset.UpsertDataIfAssetExists(data); isUnavailable(err) {
+				unavailableErr = err
+				break
+			} else if err != nil {
+				log.Error("Eliona", "dropping buffered data %d rejected by Eliona: %v", b.ID, err)
+			}
+			fingerprints.forget(fingerprintKey{data.AssetId, data.Subtype})
+			done = append(done, b.ID)
+		}
+		if len(done) > 0 {
+			if err := conf.DeleteBufferedData(context.Background(), done); err != nil {
+				return true, fmt.Errorf("deleting replayed data: %v", err)
+			}
+		}
+		if unavailableErr != nil {
+			log.Debug("Eliona", "Eliona still unavailable, replay of config %d postponed: %v", *config.Id, unavailableErr)
+			return true, nil
+		}
+	}
+}
+
+// isUnavailable tells whether the error means that Eliona could not be reached,
+// as opposed to Eliona rejecting the data.
+func isUnavailable(err error) bool {
+	if err == nil {
+		return false
+	}
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return true
+	}
+	var apiErr *api.GenericOpenAPIError
+	return errors.As(err, &apiErr) && strings.HasPrefix(apiErr.Error(), "5")
+}
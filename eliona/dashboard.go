@@ -16,13 +16,21 @@
 package eliona
 
 import (
+	"abb-free-at-home/conf"
+	"context"
+	"database/sql"
+	"errors"
 	"fmt"
+	"strings"
 
 	api "github.com/eliona-smart-building-assistant/go-eliona-api-client/v2"
 	"github.com/eliona-smart-building-assistant/go-eliona/client"
 	"github.com/eliona-smart-building-assistant/go-utils/common"
+	"github.com/eliona-smart-building-assistant/go-utils/log"
 )
 
+// GetDashboard returns a dashboard with a set of widgets for each configuration,
+// i.e. for each root asset, in the project.
 func GetDashboard(projectId string) (api.Dashboard, error) {
 	dashboard := api.Dashboard{}
 	dashboard.Name = "ABB-free@home"
@@ -31,25 +39,74 @@ func GetDashboard(projectId string) (api.Dashboard, error) {
 
 	rootAssets, _, err := client.NewClient().AssetsAPI.
 		GetAssets(client.AuthenticationContext()).
-		AssetTypeName("abb_free_at_home_root").
+		AssetTypeName(rootAssetType).
 		ProjectId(projectId).
 		Execute()
 	if err != nil {
-		return api.Dashboard{}, fmt.Errorf("fetching root asset: %v", err)
+		return api.Dashboard{}, fmt.Errorf("fetching root assets: %v", err)
 	}
-	if len(rootAssets) != 1 {
-		return api.Dashboard{}, fmt.Errorf("found %v root assets in project %v, expected 1", len(rootAssets), projectId)
+	if len(rootAssets) == 0 {
+		return api.Dashboard{}, fmt.Errorf("found no root asset in project %v", projectId)
 	}
-	rootAsset := rootAssets[0]
+	labels := rootLabels(rootAssets)
 	widgetSequence := int32(0)
+	for _, rootAsset := range rootAssets {
+		first := len(dashboard.Widgets)
+		widgetSequence, err = appendRootWidgets(&dashboard, projectId, rootAsset, widgetSequence)
+		if errors.Is(err, sql.ErrNoRows) {
+			// A root asset not known to the app, e.g. left over from a deleted configuration.
+			log.Debug("eliona", "skipping widgets for unknown root asset %d", rootAsset.GetId())
+			continue
+		} else if err != nil {
+			return api.Dashboard{}, fmt.Errorf("creating widgets for root asset %d: %v", rootAsset.GetId(), err)
+		}
+		if len(rootAssets) > 1 {
+			labelWidgets(dashboard.Widgets[first:], labels[rootAsset.GetId()])
+		}
+	}
+	return dashboard, nil
+}
 
-	switches, _, err := client.NewClient().AssetsAPI.
-		GetAssets(client.AuthenticationContext()).
-		AssetTypeName("abb_free_at_home_switch_sensor").
-		ProjectId(projectId).
-		Execute()
+// rootLabels returns the names of the root assets, followed by their GAI where several root
+// assets have the same name.
+func rootLabels(rootAssets []api.Asset) map[int32]string {
+	names := make(map[string]int)
+	for _, rootAsset := range rootAssets {
+		names[rootAsset.GetName()]++
+	}
+	labels := make(map[int32]string, len(rootAssets))
+	for _, rootAsset := range rootAssets {
+		label := rootAsset.GetName()
+		if label == "" || names[label] > 1 {
+			label = strings.TrimSpace(fmt.Sprintf("%s (%s)", label, rootAsset.GlobalAssetIdentifier))
+		}
+		labels[rootAsset.GetId()] = label
+	}
+	return labels
+}
+
+// labelWidgets prefixes the descriptions of the widget elements with the label of their root
+// asset, so that the widget sets of several configurations in a project can be told apart.
+func labelWidgets(widgets []api.Widget, label string) {
+	for _, widget := range widgets {
+		for _, data := range widget.Data {
+			if description, ok := data.Data["description"].(string); ok {
+				data.Data["description"] = fmt.Sprintf("%s: %s", label, description)
+			}
+		}
+	}
+}
+
+// appendRootWidgets adds the widgets for the assets under the root asset. Returns the next widget sequence.
+func appendRootWidgets(dashboard *api.Dashboard, projectId string, rootAsset api.Asset, widgetSequence int32) (int32, error) {
+	assetIDs, err := conf.GetAssetIDsOfSameConfig(context.Background(), rootAsset.GetId())
+	if err != nil {
+		return widgetSequence, fmt.Errorf("fetching assets of the configuration: %w", err)
+	}
+
+	switches, err := getAssets("abb_free_at_home_switch_sensor", projectId, assetIDs)
 	if err != nil {
-		return api.Dashboard{}, fmt.Errorf("fetching switches: %v", err)
+		return widgetSequence, fmt.Errorf("fetching switches: %v", err)
 	}
 	var switchesData []api.WidgetData
 	for i, sw := range switches {
@@ -89,13 +146,9 @@ func GetDashboard(projectId string) (api.Dashboard, error) {
 	widgetSequence++
 	dashboard.Widgets = append(dashboard.Widgets, widget)
 
-	dimmers, _, err := client.NewClient().AssetsAPI.
-		GetAssets(client.AuthenticationContext()).
-		AssetTypeName("abb_free_at_home_dimmer_sensor").
-		ProjectId(projectId).
-		Execute()
+	dimmers, err := getAssets("abb_free_at_home_dimmer_sensor", projectId, assetIDs)
 	if err != nil {
-		return api.Dashboard{}, fmt.Errorf("fetching dimmers: %v", err)
+		return widgetSequence, fmt.Errorf("fetching dimmers: %v", err)
 	}
 	var dimmersData []api.WidgetData
 	for i, d := range dimmers {
@@ -135,13 +188,9 @@ func GetDashboard(projectId string) (api.Dashboard, error) {
 	widgetSequence++
 	dashboard.Widgets = append(dashboard.Widgets, widget)
 
-	hueLights, _, err := client.NewClient().AssetsAPI.
-		GetAssets(client.AuthenticationContext()).
-		AssetTypeName("abb_free_at_home_hue_actuator").
-		ProjectId(projectId).
-		Execute()
+	hueLights, err := getAssets("abb_free_at_home_hue_actuator", projectId, assetIDs)
 	if err != nil {
-		return api.Dashboard{}, fmt.Errorf("fetching hueLights: %v", err)
+		return widgetSequence, fmt.Errorf("fetching hueLights: %v", err)
 	}
 
 	for _, hueLight := range hueLights {
@@ -291,13 +340,9 @@ func GetDashboard(projectId string) (api.Dashboard, error) {
 		widgetSequence++
 	}
 
-	movementSensors, _, err := client.NewClient().AssetsAPI.
-		GetAssets(client.AuthenticationContext()).
-		AssetTypeName("abb_free_at_home_movement_sensor").
-		ProjectId(projectId).
-		Execute()
+	movementSensors, err := getAssets("abb_free_at_home_movement_sensor", projectId, assetIDs)
 	if err != nil {
-		return api.Dashboard{}, fmt.Errorf("fetching movementSensors: %v", err)
+		return widgetSequence, fmt.Errorf("fetching movementSensors: %v", err)
 	}
 
 	for _, movementSensor := range movementSensors {
@@ -341,13 +386,9 @@ func GetDashboard(projectId string) (api.Dashboard, error) {
 		widgetSequence++
 	}
 
-	rtcs, _, err := client.NewClient().AssetsAPI.
-		GetAssets(client.AuthenticationContext()).
-		AssetTypeName("abb_free_at_home_room_temperature_controller").
-		ProjectId(projectId).
-		Execute()
+	rtcs, err := getAssets("abb_free_at_home_room_temperature_controller", projectId, assetIDs)
 	if err != nil {
-		return api.Dashboard{}, fmt.Errorf("fetching rtcs: %v", err)
+		return widgetSequence, fmt.Errorf("fetching rtcs: %v", err)
 	}
 
 	for _, rtc := range rtcs {
@@ -446,13 +487,9 @@ func GetDashboard(projectId string) (api.Dashboard, error) {
 		widgetSequence++
 	}
 
-	thermostats, _, err := client.NewClient().AssetsAPI.
-		GetAssets(client.AuthenticationContext()).
-		AssetTypeName("abb_free_at_home_radiator_thermostat").
-		ProjectId(projectId).
-		Execute()
+	thermostats, err := getAssets("abb_free_at_home_radiator_thermostat", projectId, assetIDs)
 	if err != nil {
-		return api.Dashboard{}, fmt.Errorf("fetching thermostats: %v", err)
+		return widgetSequence, fmt.Errorf("fetching thermostats: %v", err)
 	}
 
 	for _, thermostat := range thermostats {
@@ -554,13 +591,9 @@ func GetDashboard(projectId string) (api.Dashboard, error) {
 		widgetSequence++
 	}
 
-	windowSensors, _, err := client.NewClient().AssetsAPI.
-		GetAssets(client.AuthenticationContext()).
-		AssetTypeName("abb_free_at_home_window_sensor").
-		ProjectId(projectId).
-		Execute()
+	windowSensors, err := getAssets("abb_free_at_home_window_sensor", projectId, assetIDs)
 	if err != nil {
-		return api.Dashboard{}, fmt.Errorf("fetching windowSensors: %v", err)
+		return widgetSequence, fmt.Errorf("fetching windowSensors: %v", err)
 	}
 
 	for _, windowSensor := range windowSensors {
@@ -616,13 +649,9 @@ func GetDashboard(projectId string) (api.Dashboard, error) {
 		widgetSequence++
 	}
 
-	doorSensors, _, err := client.NewClient().AssetsAPI.
-		GetAssets(client.AuthenticationContext()).
-		AssetTypeName("abb_free_at_home_door_sensor").
-		ProjectId(projectId).
-		Execute()
+	doorSensors, err := getAssets("abb_free_at_home_door_sensor", projectId, assetIDs)
 	if err != nil {
-		return api.Dashboard{}, fmt.Errorf("fetching doorSensors: %v", err)
+		return widgetSequence, fmt.Errorf("fetching doorSensors: %v", err)
 	}
 
 	for _, doorSensor := range doorSensors {
@@ -678,13 +707,9 @@ func GetDashboard(projectId string) (api.Dashboard, error) {
 		widgetSequence++
 	}
 
-	devices, _, err := client.NewClient().AssetsAPI.
-		GetAssets(client.AuthenticationContext()).
-		AssetTypeName("abb_free_at_home_device").
-		ProjectId(projectId).
-		Execute()
+	devices, err := getAssets("abb_free_at_home_device", projectId, assetIDs)
 	if err != nil {
-		return api.Dashboard{}, fmt.Errorf("fetching devices: %v", err)
+		return widgetSequence, fmt.Errorf("fetching devices: %v", err)
 	}
 
 	var batteryDevices []api.Asset
@@ -696,7 +721,7 @@ func GetDashboard(projectId string) (api.Dashboard, error) {
 			DataSubtype(string(api.SUBTYPE_STATUS)).
 			Execute()
 		if err != nil {
-			return api.Dashboard{}, fmt.Errorf("getting device data: %v", err)
+			return widgetSequence, fmt.Errorf("getting device data: %v", err)
 		}
 		if len(data) == 0 {
 			continue
@@ -800,13 +825,9 @@ func GetDashboard(projectId string) (api.Dashboard, error) {
 	widgetSequence++
 	dashboard.Widgets = append(dashboard.Widgets, widget)
 
-	systems, _, err := client.NewClient().AssetsAPI.
-		GetAssets(client.AuthenticationContext()).
-		AssetTypeName("abb_free_at_home_system").
-		ProjectId(projectId).
-		Execute()
+	systems, err := getAssets("abb_free_at_home_system", projectId, assetIDs)
 	if err != nil {
-		return api.Dashboard{}, fmt.Errorf("fetching systems: %v", err)
+		return widgetSequence, fmt.Errorf("fetching systems: %v", err)
 	}
 
 	var systemsTilesConfig []map[string]any
@@ -859,13 +880,9 @@ func GetDashboard(projectId string) (api.Dashboard, error) {
 	widgetSequence++
 	dashboard.Widgets = append(dashboard.Widgets, widget)
 
-	scenes, _, err := client.NewClient().AssetsAPI.
-		GetAssets(client.AuthenticationContext()).
-		AssetTypeName("abb_free_at_home_scene").
-		ProjectId(projectId).
-		Execute()
+	scenes, err := getAssets("abb_free_at_home_scene", projectId, assetIDs)
 	if err != nil {
-		return api.Dashboard{}, fmt.Errorf("fetching scenes: %v", err)
+		return widgetSequence, fmt.Errorf("fetching scenes: %v", err)
 	}
 	var scenesData []api.WidgetData
 	for i, sw := range scenes {
@@ -905,7 +922,26 @@ func GetDashboard(projectId string) (api.Dashboard, error) {
 	widgetSequence++
 	dashboard.Widgets = append(dashboard.Widgets, widget)
 
-	return dashboard, nil
+	return widgetSequence, nil
+}
+
+// getAssets returns the assets of the type in the project that are among the given ones.
+func getAssets(assetType, projectId string, assetIDs map[int32]bool) ([]api.Asset, error) {
+	assets, _, err := client.NewClient().AssetsAPI.
+		GetAssets(client.AuthenticationContext()).
+		AssetTypeName(assetType).
+		ProjectId(projectId).
+		Execute()
+	if err != nil {
+		return nil, err
+	}
+	var filtered []api.Asset
+	for _, a := range assets {
+		if assetIDs[a.GetId()] {
+			filtered = append(filtered, a)
+		}
+	}
+	return filtered, nil
 }
 
 func nullableInt32(val int32) api.NullableInt32 {
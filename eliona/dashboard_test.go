@@ -0,0 +1,49 @@
+package eliona
+
+import (
+	"testing"
+
+	api "github.com/eliona-smart-building-assistant/go-eliona-api-client/v2"
+)
+
+func TestRootLabels(t *testing.T) {
+	root := func(id int32, name, gai string) api.Asset {
+		a := api.Asset{GlobalAssetIdentifier: gai}
+		a.SetId(id)
+		if name != "" {
+			a.SetName(name)
+		}
+		return a
+	}
+	labels := rootLabels([]api.Asset{
+		root(1, "Home", "abb_free_at_home_root"),
+		root(2, "Office", "cfg2_abb_free_at_home_root"),
+		root(3, "Office", "cfg3_abb_free_at_home_root"),
+		root(4, "", "cfg4_abb_free_at_home_root"),
+	})
+	want := map[int32]string{
+		1: "Home",
+		2: "Office (cfg2_abb_free_at_home_root)",
+		3: "Office (cfg3_abb_free_at_home_root)",
+		4: "(cfg4_abb_free_at_home_root)",
+	}
+	for id, label := range want {
+		if labels[id] != label {
+			t.Errorf("label of root asset %d = %q, want %q", id, labels[id], label)
+		}
+	}
+}
+
+func TestLabelWidgets(t *testing.T) {
+	widgets := []api.Widget{{Data: []api.WidgetData{
+		{Data: map[string]interface{}{"description": "Kitchen light"}},
+		{Data: map[string]interface{}{"attribute": "switch"}},
+	}}}
+	labelWidgets(widgets, "Home")
+	if got := widgets[0].Data[0].Data["description"]; got != "Home: Kitchen light" {
+		t.Errorf("description = %v, want %q", got, "Home: Kitchen light")
+	}
+	if _, ok := widgets[0].Data[1].Data["description"]; ok {
+		t.Errorf("description added to element without one")
+	}
+}
@@ -7,80 +7,203 @@ import (
 	"abb-free-at-home/model"
 	"context"
 	"fmt"
-	"strconv"
-	"strings"
+	"sync"
+	"time"
 
 	api "github.com/eliona-smart-building-assistant/go-eliona-api-client/v2"
 	"github.com/eliona-smart-building-assistant/go-eliona/asset"
+	"github.com/eliona-smart-building-assistant/go-utils/common"
 	"github.com/eliona-smart-building-assistant/go-utils/log"
 )
 
 const ClientReference string = "abb-free-at-home"
 
+// Limits for pushing the periodically collected data to Eliona.
+const (
+	upsertBatchSize        = 100
+	upsertBatchConcurrency = 4
+)
+
+type pendingData struct {
+	data        api.Data
+	fingerprint uint64
+}
+
+// UpsertSystemsData pushes the current state of all systems, devices and channels to Eliona.
+// Only payloads that changed since the last push are sent, in concurrent batches per project.
 func UpsertSystemsData(config apiserver.Configuration, systems []model.System) error {
-	for _, projectId := range *config.ProjectIDs {
-		for _, system := range systems {
-			log.Debug("Eliona", "upserting data for system: config %d and system '%s'", config.Id, fmt.Sprintf("%s_%s", system.AssetType(), system.GAI))
-			assetId, err := conf.GetAssetId(context.Background(), config, projectId, fmt.Sprintf("%s_%s", system.AssetType(), system.GAI))
-			if err != nil {
-				return err
-			}
-			if assetId == nil {
-				continue
+	transformations, err := conf.GetAttributeTransformations(context.Background(), config)
+	if err != nil {
+		return fmt.Errorf("fetching attribute transformations: %v", err)
+	}
+	for _, projectId := range conf.AllProjIds(config) {
+		assets, err := conf.GetAssetsByGAI(context.Background(), config, projectId)
+		if err != nil {
+			return fmt.Errorf("fetching assets for project %s: %v", projectId, err)
+		}
+		var pending []pendingData
+		collect := func(gai string, data any) error {
+			ast, ok := assets[gai]
+			if !ok || !ast.AssetID.Valid {
+				log.Debug("Eliona", "no asset for '%s' in project %s, skipping data", gai, projectId)
+				return nil
 			}
-
-			data := asset.Data{
-				AssetId:         *assetId,
-				Data:            system,
-				ClientReference: ClientReference,
+			changed, err := changedData(ast, data, transformations)
+			if err != nil {
+				return fmt.Errorf("collecting data for '%s': %v", gai, err)
 			}
-			if asset.UpsertAssetDataIfAssetExists(data); err != nil {
-				return fmt.Errorf("upserting data: %v", err)
+			pending = append(pending, changed...)
+			return nil
+		}
+		for _, system := range systemsInProject(config, systems, projectId) {
+			if err := collect(fmt.Sprintf("%s_%s", system.AssetType(), system.GAI), system); err != nil {
+				return err
 			}
 			for _, device := range system.Devices {
-				log.Debug("Eliona", "upserting data for device: config %d and device '%s'", config.Id, fmt.Sprintf("%s_%s", device.AssetType(), device.GAI))
-				assetId, err := conf.GetAssetId(context.Background(), config, projectId, fmt.Sprintf("%s_%s", device.AssetType(), device.GAI))
-				if err != nil {
+				if err := collect(fmt.Sprintf("%s_%s", device.AssetType(), device.GAI), device); err != nil {
 					return err
 				}
-				if assetId == nil {
-					continue
-				}
-
-				data := asset.Data{
-					AssetId:         *assetId,
-					Data:            device,
-					ClientReference: ClientReference,
-				}
-				if asset.UpsertAssetDataIfAssetExists(data); err != nil {
-					return fmt.Errorf("upserting data: %v", err)
-				}
 				for _, channel := range device.Channels {
-					log.Debug("Eliona", "upserting data for asset: config %d and asset '%v'", config.Id, channel.GAI())
-					assetId, err := conf.GetAssetId(context.Background(), config, projectId, channel.GAI())
-					if err != nil {
+					if err := collect(channel.GAI(), channel); err != nil {
 						return err
 					}
-					if assetId == nil {
-						return fmt.Errorf("unable to find asset ID")
-					}
-
-					data := asset.Data{
-						AssetId:         *assetId,
-						Data:            channel,
-						ClientReference: ClientReference,
-					}
-					if asset.UpsertAssetDataIfAssetExists(data); err != nil {
-						return fmt.Errorf("upserting data: %v", err)
-					}
 				}
 			}
 		}
+		log.Debug("Eliona", "upserting %d changed data for config %d and project %s", len(pending), *config.Id, projectId)
+		if err := upsertInBatches(pending); err != nil {
+			return fmt.Errorf("upserting data for project %s: %v", projectId, err)
+		}
 	}
 	return nil
 }
 
-func UpsertDatapointData(config apiserver.Configuration, datapoint appdb.Datapoint, value string) error {
+// changedData splits the struct into subtypes and returns those that differ from the last push.
+func changedData(ast *appdb.Asset, data any, transformations map[conf.AttributeKey][]apiserver.TransformationRule) ([]pendingData, error) {
+	var changed []pendingData
+	for subtype, subData := range asset.SplitBySubtype(data) {
+		transformData(ast, data, subtype, subData, transformations)
+		fp, err := fingerprint(subData)
+		if err != nil {
+			return nil, err
+		}
+		if fingerprints.unchanged(fingerprintKey{ast.AssetID.Int32, subtype}, fp) {
+			continue
+		}
+		changed = append(changed, pendingData{
+			data: api.Data{
+				AssetId:         ast.AssetID.Int32,
+				Subtype:         subtype,
+				Timestamp:       *api.NewNullableTime(sourceTimestamp(data, subtype)),
+				Data:            subData,
+				AssetTypeName:   *api.NewNullableString(common.Ptr(ast.AssetTypeName)),
+				ClientReference: *api.NewNullableString(common.Ptr(ClientReference)),
+			},
+			fingerprint: fp,
+		})
+	}
+	return changed, nil
+}
+
+// transformData replaces the values of attributes with transformation rules by the transformed
+// value of their datapoint. Attributes whose value cannot be transformed are left out.
+func transformData(ast *appdb.Asset, data any, subtype api.DataSubtype, subData map[string]interface{}, transformations map[conf.AttributeKey][]apiserver.TransformationRule) {
+	channel, ok := data.(model.Asset)
+	if !ok {
+		return
+	}
+	for _, datapoint := range channel.Outputs() {
+		for _, attr := range datapoint.Map {
+			if attr.Subtype != subtype {
+				continue
+			}
+			rules, ok := transformations[conf.AttributeKey{AssetID: ast.AssetID.Int32, Subtype: string(subtype), Attribute: attr.AttributeName}]
+			if !ok {
+				continue
+			}
+			value, err := model.Transform(datapoint.Value, datapoint.Dpt, rules)
+			if err != nil {
+				log.Warn("Eliona", "transforming value '%s' of datapoint %s for attribute %s: %v", datapoint.Value, datapoint.Name, attr.AttributeName, err)
+				delete(subData, attr.AttributeName)
+				continue
+			}
+			subData[attr.AttributeName] = value
+		}
+	}
+}
+
+// sourceTimestamp returns when ABB last changed any of the values mapped to the
+// subtype, so that Eliona trends show the time of the change, not of the poll.
+func sourceTimestamp(data any, subtype api.DataSubtype) *time.Time {
+	channel, ok := data.(model.Asset)
+	if !ok {
+		return nil
+	}
+	var latest time.Time
+	for _, datapoint := range channel.Outputs() {
+		for _, attr := range datapoint.Map {
+			if attr.Subtype == subtype && datapoint.Time.After(latest) {
+				latest = datapoint.Time
+			}
+		}
+	}
+	if latest.IsZero() {
+		return nil
+	}
+	return &latest
+}
+
+func upsertInBatches(pending []pendingData) error {
+	var wg sync.WaitGroup
+	var errOnce sync.Once
+	var firstErr error
+	sem := make(chan struct{}, upsertBatchConcurrency)
+	for start := 0; start < len(pending); start += upsertBatchSize {
+		batch := pending[start:min(start+upsertBatchSize, len(pending))]
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			if err := upsertBatch(batch); err != nil {
+				errOnce.Do(func() { firstErr = err })
+			}
+		}()
+	}
+	wg.Wait()
+	return firstErr
+}
+
+func upsertBatch(batch []pendingData) error {
+	datas := make([]api.Data, len(batch))
+	for i, p := range batch {
+		datas[i] = p.data
+	}
+	if err := asset.UpsertDataBulk(datas); err == nil {
+		for _, p := range batch {
+			fingerprints.remember(fingerprintKey{p.data.AssetId, p.data.Subtype}, p.fingerprint)
+		}
+		return nil
+	}
+	// The whole batch fails if one of the assets was deleted in Eliona in the
+	// meantime. Retry one by one to deliver the rest.
+	log.Debug("Eliona", "bulk upsert of %d data failed, retrying one by one", len(batch))
+	var firstErr error
+	for _, p := range batch {
+		if err := asset.UpsertDataIfAssetExists(p.data); err != nil {
+			if firstErr == nil {
+				firstErr = fmt.Errorf("upserting data for asset %d: %v", p.data.AssetId, err)
+			}
+			continue
+		}
+		fingerprints.remember(fingerprintKey{p.data.AssetId, p.data.Subtype}, p.fingerprint)
+	}
+	return firstErr
+}
+
+// UpsertDatapointData writes the value of the ABB datapoint to all attributes linked to it.
+// The timestamp is when the value changed at ABB; nil means now.
+func UpsertDatapointData(config apiserver.Configuration, datapoint appdb.Datapoint, value string, timestamp *time.Time) error {
 	attributes, err := datapoint.DatapointAttributes().AllG(context.Background())
 	if err != nil {
 		return fmt.Errorf("fetching datapoint attributes: %v", err)
@@ -89,7 +212,7 @@ func UpsertDatapointData(config apiserver.Configuration, datapoint appdb.Datapoi
 	if err != nil {
 		return fmt.Errorf("fetching datapoint asset: %v", err)
 	}
-	for _, projectId := range *config.ProjectIDs {
+	for _, projectId := range conf.SystemProjIds(config, datapoint.SystemID) {
 		for _, attribute := range attributes {
 			log.Debug("Eliona", "upserting data %v for datapoint: config %d and asset '%v'", value, config.Id, ast.GlobalAssetID)
 			assetId, err := conf.GetAssetId(context.Background(), config, projectId, ast.GlobalAssetID)
@@ -99,8 +222,13 @@ func UpsertDatapointData(config apiserver.Configuration, datapoint appdb.Datapoi
 			if assetId == nil {
 				return fmt.Errorf("unable to find asset ID")
 			}
+			converted, err := attributeValue(attribute, value, datapoint.DPT.String)
+			if err != nil {
+				log.Warn("Eliona", "transforming value '%s' of datapoint %s for attribute %s: %v", value, datapoint.Datapoint, attribute.AttributeName, err)
+				continue
+			}
 			data := map[string]interface{}{
-				attribute.AttributeName: convertToNumber(value),
+				attribute.AttributeName: converted,
 			}
 
 			cr := ClientReference
@@ -108,19 +236,48 @@ func UpsertDatapointData(config apiserver.Configuration, datapoint appdb.Datapoi
 				AssetId:         *assetId,
 				Data:            data,
 				Subtype:         api.DataSubtype(attribute.Subtype),
+				Timestamp:       *api.NewNullableTime(timestamp),
 				AssetTypeName:   *api.NewNullableString(&ast.AssetTypeName),
 				ClientReference: *api.NewNullableString(&cr),
 			}
-			if asset.UpsertDataIfAssetExists(apidata); err != nil {
+			if err := deliverOrBuffer(config, apidata); err != nil {
 				return fmt.Errorf("upserting data: %v", err)
 			}
+			fingerprints.forget(fingerprintKey{*assetId, apidata.Subtype})
 		}
 	}
 	return nil
 }
 
+// attributeValue converts the ABB value for the attribute, applying the rules of the link if any.
+func attributeValue(attribute *appdb.DatapointAttribute, value, dpt string) (any, error) {
+	rules, err := conf.TransformationRules(attribute)
+	if err != nil {
+		return nil, err
+	}
+	if rules == nil {
+		return model.ConvertValue(value, dpt), nil
+	}
+	return model.Transform(value, dpt, rules)
+}
+
+// InputValue converts a value written to the attribute of the asset in Eliona to the value sent to
+// the ABB input, reversing the transformation rules of the attribute if any.
+func InputValue(assetID int32, attribute string, value any) (float64, error) {
+	rules, err := conf.GetAttributeTransformation(context.Background(), conf.AttributeKey{AssetID: assetID, Subtype: string(api.SUBTYPE_OUTPUT), Attribute: attribute})
+	if err != nil {
+		return 0, fmt.Errorf("fetching transformation: %v", err)
+	}
+	if rules != nil {
+		if value, err = model.ReverseTransform(value, rules); err != nil {
+			return 0, fmt.Errorf("reversing transformation: %v", err)
+		}
+	}
+	return model.ToFloat(value)
+}
+
 func UpsertSystemStatus(config apiserver.Configuration, system appdb.Asset, status int8) error {
-	for _, projectId := range *config.ProjectIDs {
+	for _, projectId := range conf.SystemProjIds(config, system.ProviderID) {
 		log.Debug("Eliona", "upserting status for system: config %d and asset '%v'", config.Id, system.GlobalAssetID)
 		assetId, err := conf.GetAssetId(context.Background(), config, projectId, system.GlobalAssetID)
 		if err != nil {
@@ -141,28 +298,10 @@ func UpsertSystemStatus(config apiserver.Configuration, system appdb.Asset, stat
 			AssetTypeName:   *api.NewNullableString(&system.AssetTypeName),
 			ClientReference: *api.NewNullableString(&cr),
 		}
-		if asset.UpsertDataIfAssetExists(apidata); err != nil {
+		if err := deliverOrBuffer(config, apidata); err != nil {
 			return fmt.Errorf("upserting data: %v", err)
 		}
+		fingerprints.forget(fingerprintKey{*assetId, apidata.Subtype})
 	}
 	return nil
 }
-
-// convertToNumber tries to convert a string to an integer or a float.
-// If conversion is not possible, it returns the original string.
-func convertToNumber(s string) any {
-	if strings.Contains(s, ".") {
-		// Try converting to float
-		val, err := strconv.ParseFloat(s, 64)
-		if err != nil {
-			return s
-		}
-		return val
-	}
-	// Try converting to integer
-	val, err := strconv.Atoi(s)
-	if err != nil {
-		return s
-	}
-	return val
-}
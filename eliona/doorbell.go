@@ -0,0 +1,58 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package eliona
+
+import (
+	"abb-free-at-home/apiserver"
+	"abb-free-at-home/appdb"
+	"abb-free-at-home/conf"
+	"context"
+	"fmt"
+
+	api "github.com/eliona-smart-building-assistant/go-eliona-api-client/v2"
+	"github.com/eliona-smart-building-assistant/go-utils/common"
+)
+
+// NotifyRing notifies the user that the door ringing sensor of the datapoint reported a ring, if
+// ring notifications are enabled for the configuration.
+func NotifyRing(config apiserver.Configuration, datapoint appdb.Datapoint) error {
+	if !common.Val(config.RingNotifications) || config.UserId == nil {
+		return nil
+	}
+	ast, err := datapoint.Asset().OneG(context.Background())
+	if err != nil {
+		return fmt.Errorf("fetching datapoint asset: %v", err)
+	}
+	name := ast.Name.String
+	if !ast.Name.Valid {
+		name = ast.GlobalAssetID
+	}
+	for _, projectId := range conf.SystemProjIds(config, datapoint.SystemID) {
+		if err := notifyUserAboutRing(*config.UserId, projectId, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// notifyUserAboutRing leaves out the time of the ring, the notification has its own timestamp.
+func notifyUserAboutRing(userId string, projectId string, doorName string) error {
+	message := api.Translation{
+		De: api.PtrString(fmt.Sprintf("ABB-Free@home App: Es hat an %s geklingelt.", doorName)),
+		En: api.PtrString(fmt.Sprintf("ABB-Free@home app: %s rang.", doorName)),
+	}
+	return postNotification(userId, projectId, message)
+}
@@ -0,0 +1,71 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package eliona
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"sync"
+
+	api "github.com/eliona-smart-building-assistant/go-eliona-api-client/v2"
+)
+
+type fingerprintKey struct {
+	assetID int32
+	subtype api.DataSubtype
+}
+
+// fingerprintCache remembers the last payload pushed to Eliona for each asset
+// and subtype. It lives in memory only, so everything is sent again after a restart.
+type fingerprintCache struct {
+	mu sync.Mutex
+	m  map[fingerprintKey]uint64
+}
+
+var fingerprints = fingerprintCache{m: make(map[fingerprintKey]uint64)}
+
+func (c *fingerprintCache) unchanged(key fingerprintKey, fp uint64) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	last, ok := c.m[key]
+	return ok && last == fp
+}
+
+func (c *fingerprintCache) remember(key fingerprintKey, fp uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.m[key] = fp
+}
+
+// forget makes sure the next periodic upsert of the asset's subtype is sent,
+// because the data in Eliona was changed by another path (e.g. a subscription).
+func (c *fingerprintCache) forget(key fingerprintKey) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.m, key)
+}
+
+func fingerprint(data map[string]interface{}) (uint64, error) {
+	// Maps are marshalled with sorted keys, so equal data gives equal bytes.
+	b, err := json.Marshal(data)
+	if err != nil {
+		return 0, fmt.Errorf("marshalling data: %v", err)
+	}
+	h := fnv.New64a()
+	h.Write(b)
+	return h.Sum64(), nil
+}
@@ -0,0 +1,122 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package eliona
+
+import (
+	"abb-free-at-home/apiserver"
+	"abb-free-at-home/model"
+	"regexp"
+	"strings"
+
+	"github.com/eliona-smart-building-assistant/go-utils/common"
+)
+
+// Default naming templates, used if the configuration does not define its own.
+const (
+	defaultDeviceNameTemplate         = "{floor} | {room} | {device}"
+	defaultChannelNameTemplate        = "{floor} | {room} | {channel}"
+	defaultDeviceDescriptionTemplate  = "{device} ({gai})"
+	defaultChannelDescriptionTemplate = "{channel} ({gai})"
+)
+
+// translatedPlaceholder matches placeholders of a name in a given language, e.g. {device:de}.
+var translatedPlaceholder = regexp.MustCompile(`\{(device|channel):([a-z]+)\}`)
+
+// nameParts are the values available to the naming templates.
+type nameParts struct {
+	system       string
+	floor        string
+	room         string
+	device       string
+	deviceNames  map[string]string
+	serial       string
+	channel      string
+	channelNames map[string]string
+	function     string
+	gai          string
+}
+
+// deviceNameParts resolves the floor and room of the device from the locations fetched from ABB.
+func deviceNameParts(system model.System, device model.Device, locations []model.Floor) nameParts {
+	p := nameParts{
+		system:      system.Name,
+		device:      device.Name,
+		deviceNames: device.Names,
+		serial:      device.ID,
+		gai:         device.GAI,
+	}
+	if floor, room := model.FindRoom(locations, device.Location); room != nil {
+		p.floor = floor.Name
+		p.room = room.Name
+	}
+	return p
+}
+
+func (p nameParts) withChannel(channel model.Asset) nameParts {
+	p.channel = channel.Name()
+	p.channelNames = channel.Names()
+	p.function = channel.FunctionName()
+	p.gai = channel.GAI()
+	return p
+}
+
+// render fills in the template. Segments separated by "|" that are empty after filling in,
+// e.g. the room of a device without location, are left out.
+func (p nameParts) render(template, defaultTemplate string) string {
+	if template == "" {
+		template = defaultTemplate
+	}
+	template = translatedPlaceholder.ReplaceAllStringFunc(template, func(placeholder string) string {
+		m := translatedPlaceholder.FindStringSubmatch(placeholder)
+		if m[1] == "device" {
+			return model.Localized(p.deviceNames, m[2], p.device)
+		}
+		return model.Localized(p.channelNames, m[2], p.channel)
+	})
+	filled := strings.NewReplacer(
+		"{system}", p.system,
+		"{floor}", p.floor,
+		"{room}", p.room,
+		"{device}", p.device,
+		"{serial}", p.serial,
+		"{channel}", p.channel,
+		"{function}", p.function,
+		"{gai}", p.gai,
+	).Replace(template)
+	var segments []string
+	for _, segment := range strings.Split(filled, "|") {
+		if segment = strings.TrimSpace(segment); segment != "" {
+			segments = append(segments, segment)
+		}
+	}
+	return strings.Join(segments, " | ")
+}
+
+func deviceName(config apiserver.Configuration, p nameParts) string {
+	return p.render(common.Val(config.DeviceNameTemplate), defaultDeviceNameTemplate)
+}
+
+func deviceDescription(config apiserver.Configuration, p nameParts) string {
+	return p.render(common.Val(config.DeviceDescriptionTemplate), defaultDeviceDescriptionTemplate)
+}
+
+func channelName(config apiserver.Configuration, p nameParts) string {
+	return p.render(common.Val(config.ChannelNameTemplate), defaultChannelNameTemplate)
+}
+
+func channelDescription(config apiserver.Configuration, p nameParts) string {
+	return p.render(common.Val(config.ChannelDescriptionTemplate), defaultChannelDescriptionTemplate)
+}
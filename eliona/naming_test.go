@@ -0,0 +1,62 @@
+package eliona
+
+import (
+	"abb-free-at-home/apiserver"
+	"abb-free-at-home/model"
+	"testing"
+
+	"github.com/eliona-smart-building-assistant/go-utils/common"
+)
+
+func TestNameTemplates(t *testing.T) {
+	locations := []model.Floor{{Id: "f1", Name: "Ground floor", Rooms: []model.Room{{Id: "r1", Name: "Kitchen"}}}}
+	system := model.System{Name: "Home"}
+	device := model.Device{
+		ID:       "ABB700000001",
+		GAI:      "sys_ABB700000001",
+		Name:     "Light switch",
+		Names:    map[string]string{"de": "Lichtschalter"},
+		Location: "r1",
+	}
+	channel := model.Switch{AssetBase: model.AssetBase{
+		GAIBase:      "sys_ABB700000001_ch0000",
+		NameBase:     "Ceiling light",
+		NamesBase:    map[string]string{"de": "Deckenlicht"},
+		FunctionBase: "Switch actuator",
+	}}
+	located := deviceNameParts(system, device, locations)
+	device.Location = "unknown"
+	unlocated := deviceNameParts(system, device, locations)
+
+	tests := []struct {
+		name     string
+		template string
+		render   func(apiserver.Configuration, nameParts) string
+		parts    nameParts
+		want     string
+	}{
+		{"default device name", "", deviceName, located, "Ground floor | Kitchen | Light switch"},
+		{"default channel name", "", channelName, located.withChannel(channel), "Ground floor | Kitchen | Ceiling light"},
+		{"default device description", "", deviceDescription, located, "Light switch (sys_ABB700000001)"},
+		{"default channel description", "", channelDescription, located.withChannel(channel), "Ceiling light (abb_free_at_home_switch_sensor_sys_ABB700000001_ch0000)"},
+		{"empty segments left out", "", deviceName, unlocated, "Light switch"},
+		{"all placeholders", "{system}|{room}|{device}|{serial}", deviceName, located, "Home | Kitchen | Light switch | ABB700000001"},
+		{"function", "{function}: {channel}", channelName, located.withChannel(channel), "Switch actuator: Ceiling light"},
+		{"translated", "{device:de} - {channel:de}", channelName, located.withChannel(channel), "Lichtschalter - Deckenlicht"},
+		{"missing translation", "{device:fr}", deviceName, located, "Light switch"},
+		{"unknown placeholder kept", "{device} {colour}", deviceName, located, "Light switch {colour}"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := apiserver.Configuration{
+				DeviceNameTemplate:         common.Ptr(tt.template),
+				ChannelNameTemplate:        common.Ptr(tt.template),
+				DeviceDescriptionTemplate:  common.Ptr(tt.template),
+				ChannelDescriptionTemplate: common.Ptr(tt.template),
+			}
+			if got := tt.render(config, tt.parts); got != tt.want {
+				t.Errorf("render() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
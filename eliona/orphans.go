@@ -0,0 +1,172 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package eliona
+
+import (
+	"abb-free-at-home/apiserver"
+	"abb-free-at-home/appdb"
+	"abb-free-at-home/conf"
+	"abb-free-at-home/model"
+	"context"
+	"fmt"
+	"time"
+
+	api "github.com/eliona-smart-building-assistant/go-eliona-api-client/v2"
+	"github.com/eliona-smart-building-assistant/go-eliona/client"
+	"github.com/eliona-smart-building-assistant/go-utils/log"
+)
+
+// RetireOrphanedAssets compares the entities reported by ABB with the assets stored
+// for the configuration. Assets missing for longer than the grace period are retired:
+// their datapoints are no longer subscribed, the user is notified and, depending on
+// the orphan policy, they are deleted from Eliona.
+func RetireOrphanedAssets(config apiserver.Configuration, locations []model.Floor, systems []model.System, stats *SyncStats) error {
+	if !canDetectOrphans(config, systems) {
+		return nil
+	}
+	gracePeriod := time.Duration(0)
+	if config.OrphanGracePeriod != nil {
+		gracePeriod = time.Duration(*config.OrphanGracePeriod) * time.Second
+	}
+	deleteRetired := config.OrphanPolicy != nil && *config.OrphanPolicy == conf.ORPHAN_POLICY_DELETE
+
+	now := time.Now()
+	// Assets excluded by the asset filter or of systems moved to other projects still
+	// exist at ABB. They are no longer updated, but never retired.
+	present := reportedGAIs(locations, systems)
+	for _, projectId := range conf.AllProjIds(config) {
+		assets, err := conf.GetAssetsByGAI(context.Background(), config, projectId)
+		if err != nil {
+			return fmt.Errorf("fetching assets for project %s: %v", projectId, err)
+		}
+		retired := 0
+		for gai, ast := range assets {
+			if ast.AssetTypeName == rootAssetType {
+				continue
+			}
+			if present[gai] {
+				if ast.OrphanedAt.Valid {
+					log.Info("Eliona", "asset '%s' is reported by ABB again", gai)
+					if err := conf.ClearAssetOrphaned(context.Background(), ast); err != nil {
+						return fmt.Errorf("clearing orphan mark of '%s': %v", gai, err)
+					}
+				}
+				continue
+			}
+			if !ast.OrphanedAt.Valid {
+				log.Info("Eliona", "asset '%s' is no longer reported by ABB", gai)
+				if err := conf.MarkAssetOrphaned(context.Background(), ast, now); err != nil {
+					return fmt.Errorf("marking '%s' as orphaned: %v", gai, err)
+				}
+				if stats != nil {
+					stats.Orphaned++
+				}
+				continue
+			}
+			if ast.RetiredAt.Valid || now.Sub(ast.OrphanedAt.Time) < gracePeriod {
+				continue
+			}
+			if err := retireAsset(ast, deleteRetired, now); err != nil {
+				return fmt.Errorf("retiring '%s': %v", gai, err)
+			}
+			retired++
+			if stats != nil {
+				stats.Retired++
+			}
+		}
+		if retired > 0 && config.UserId != nil {
+			if err := notifyUserAboutRetiredAssets(*config.UserId, projectId, retired, deleteRetired); err != nil {
+				return fmt.Errorf("notifying users about retired assets: %v", err)
+			}
+		}
+	}
+	return nil
+}
+
+// canDetectOrphans tells whether the reported systems are complete enough to treat missing
+// entities as removed.
+func canDetectOrphans(config apiserver.Configuration, systems []model.System) bool {
+	if len(systems) == 0 {
+		// More likely an ABB hiccup than all systems removed at once.
+		log.Debug("Eliona", "no systems reported, skipping orphan detection for config %d", *config.Id)
+		return false
+	}
+	for _, system := range systems {
+		if system.ConnectionKnown && system.ConnectionStatus == 0 {
+			// A disconnected SysAP might not report all its devices.
+			log.Debug("Eliona", "system %s is disconnected, skipping orphan detection for config %d", system.ID, *config.Id)
+			return false
+		}
+	}
+	return true
+}
+
+// reportedGAIs returns the GAIs of all entities reported by ABB, including those excluded by
+// the asset filter.
+func reportedGAIs(locations []model.Floor, systems []model.System) map[string]bool {
+	present := make(map[string]bool)
+	for _, floor := range locations {
+		present[floor.GAI()] = true
+		for _, room := range floor.Rooms {
+			present[room.GAI()] = true
+		}
+	}
+	for _, system := range systems {
+		present[fmt.Sprintf("%s_%s", system.AssetType(), system.GAI)] = true
+		for _, gai := range system.Filtered {
+			present[gai] = true
+		}
+		for _, device := range system.Devices {
+			present[fmt.Sprintf("%s_%s", device.AssetType(), device.GAI)] = true
+			for _, channel := range device.Channels {
+				present[channel.GAI()] = true
+			}
+		}
+	}
+	return present
+}
+
+func retireAsset(ast *appdb.Asset, deleteFromEliona bool, now time.Time) error {
+	if !deleteFromEliona {
+		log.Info("Eliona", "retiring asset '%s' missing at ABB since %v", ast.GlobalAssetID, ast.OrphanedAt.Time)
+		return conf.MarkAssetRetired(context.Background(), ast, now)
+	}
+	log.Info("Eliona", "deleting asset '%s' missing at ABB since %v", ast.GlobalAssetID, ast.OrphanedAt.Time)
+	if ast.AssetID.Valid {
+		resp, err := client.NewClient().AssetsAPI.
+			DeleteAssetById(client.AuthenticationContext(), ast.AssetID.Int32).
+			Execute()
+		// Already deleted in Eliona is fine.
+		if err != nil && (resp == nil || resp.StatusCode != 404) {
+			return fmt.Errorf("deleting asset %d from Eliona: %v", ast.AssetID.Int32, err)
+		}
+	}
+	return conf.DeleteAsset(context.Background(), ast)
+}
+
+func notifyUserAboutRetiredAssets(userId string, projectId string, retired int, deleted bool) error {
+	message := api.Translation{
+		De: api.PtrString(fmt.Sprintf("ABB-Free@home App: %d Assets sind in ABB nicht mehr vorhanden und werden nicht mehr aktualisiert.", retired)),
+		En: api.PtrString(fmt.Sprintf("ABB-Free@home app: %d assets no longer exist at ABB and are not updated anymore.", retired)),
+	}
+	if deleted {
+		message = api.Translation{
+			De: api.PtrString(fmt.Sprintf("ABB-Free@home App hat %d Assets gelöscht, die in ABB nicht mehr vorhanden sind.", retired)),
+			En: api.PtrString(fmt.Sprintf("ABB-Free@home app deleted %d assets that no longer exist at ABB.", retired)),
+		}
+	}
+	return postNotification(userId, projectId, message)
+}
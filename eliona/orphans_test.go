@@ -0,0 +1,65 @@
+package eliona
+
+import (
+	"abb-free-at-home/apiserver"
+	"abb-free-at-home/model"
+	"testing"
+
+	"github.com/eliona-smart-building-assistant/go-utils/common"
+)
+
+func TestCanDetectOrphans(t *testing.T) {
+	config := apiserver.Configuration{Id: common.Ptr[int64](1)}
+	tests := []struct {
+		name    string
+		systems []model.System
+		want    bool
+	}{
+		{"no systems", nil, false},
+		{"connected", []model.System{{ID: "a", ConnectionKnown: true, ConnectionStatus: 1}}, true},
+		{"disconnected", []model.System{{ID: "a", ConnectionKnown: true, ConnectionStatus: 0}}, false},
+		{"one of several disconnected", []model.System{
+			{ID: "a", ConnectionKnown: true, ConnectionStatus: 1},
+			{ID: "b", ConnectionKnown: true, ConnectionStatus: 0},
+		}, false},
+		{"connection unknown", []model.System{{ID: "a"}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := canDetectOrphans(config, tt.systems); got != tt.want {
+				t.Errorf("canDetectOrphans() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReportedGAIs(t *testing.T) {
+	locations := []model.Floor{{Id: "f1", SystemID: "sys", Rooms: []model.Room{{Id: "r1"}}}}
+	systems := []model.System{{
+		ID:  "sys",
+		GAI: "sys",
+		Devices: []model.Device{{
+			ID:       "dev",
+			GAI:      "sys_dev",
+			Channels: []model.Asset{model.Switch{AssetBase: model.AssetBase{IDBase: "ch0", GAIBase: "sys_dev_ch0"}}},
+		}},
+		Filtered: []string{"abb_free_at_home_switch_sensor_sys_dev_ch1", "abb_free_at_home_device_sys_other"},
+	}}
+	present := reportedGAIs(locations, systems)
+	for _, gai := range []string{
+		locations[0].GAI(),
+		locations[0].Rooms[0].GAI(),
+		"abb_free_at_home_system_sys",
+		"abb_free_at_home_device_sys_dev",
+		"abb_free_at_home_switch_sensor_sys_dev_ch0",
+		"abb_free_at_home_switch_sensor_sys_dev_ch1",
+		"abb_free_at_home_device_sys_other",
+	} {
+		if !present[gai] {
+			t.Errorf("%s not reported as present", gai)
+		}
+	}
+	if present["abb_free_at_home_device_sys_removed"] {
+		t.Errorf("removed device reported as present")
+	}
+}
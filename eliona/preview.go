@@ -0,0 +1,176 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package eliona
+
+import (
+	"abb-free-at-home/apiserver"
+	"abb-free-at-home/appdb"
+	"abb-free-at-home/conf"
+	"abb-free-at-home/model"
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/eliona-smart-building-assistant/go-utils/common"
+	"github.com/volatiletech/null/v8"
+)
+
+// PreviewAssets runs the asset creation and orphan detection without writing anything to
+// Eliona or the app database. It returns the changes per project.
+func PreviewAssets(config apiserver.Configuration, locations []model.Floor, systems []model.System) ([]apiserver.AssetPreview, error) {
+	preview := &assetPreview{
+		projects:     make(map[string]*apiserver.AssetPreview),
+		placeholders: make(map[string]int32),
+		gais:         make(map[int32]string),
+		loaded:       make(map[string]bool),
+	}
+	if err := createLocationAssets(config, locations, nil, preview); err != nil {
+		return nil, fmt.Errorf("previewing location assets: %v", err)
+	}
+	if err := createAssets(config, locations, systems, nil, preview); err != nil {
+		return nil, fmt.Errorf("previewing assets: %v", err)
+	}
+	if err := previewOrphanedAssets(config, locations, systems, preview); err != nil {
+		return nil, fmt.Errorf("previewing orphaned assets: %v", err)
+	}
+	var previews []apiserver.AssetPreview
+	for _, projectId := range conf.AllProjIds(config) {
+		previews = append(previews, *preview.project(projectId))
+	}
+	return previews, nil
+}
+
+// assetPreview collects the changes upsertAsset would make. Assets that would be created get
+// a negative placeholder ID, so that their children can refer to them.
+type assetPreview struct {
+	projects     map[string]*apiserver.AssetPreview
+	placeholders map[string]int32 // Keyed by project ID and GAI
+	gais         map[int32]string // GAIs of the existing and placeholder asset IDs
+	loaded       map[string]bool  // Projects whose existing assets are in gais
+}
+
+func (p *assetPreview) project(projectId string) *apiserver.AssetPreview {
+	if pr, ok := p.projects[projectId]; ok {
+		return pr
+	}
+	pr := &apiserver.AssetPreview{
+		ProjectId: projectId,
+		Created:   []apiserver.AssetPreviewChange{},
+		Renamed:   []apiserver.AssetPreviewChange{},
+		Moved:     []apiserver.AssetPreviewChange{},
+		Orphaned:  []apiserver.AssetPreviewChange{},
+	}
+	p.projects[projectId] = pr
+	return pr
+}
+
+// placeholderID returns the ID an asset created by the preview got. It is safe to call on nil.
+func (p *assetPreview) placeholderID(projectId, gai string) (int32, bool) {
+	if p == nil {
+		return 0, false
+	}
+	id, ok := p.placeholders[projectId+"/"+gai]
+	return id, ok
+}
+
+func (p *assetPreview) record(d assetData, current *appdb.Asset, existedInApp bool) (created bool, assetID int32, err error) {
+	pr := p.project(d.projectId)
+	change := apiserver.AssetPreviewChange{
+		Gai:       d.identifier,
+		AssetType: d.assetType,
+		Name:      d.name,
+	}
+	if !existedInApp {
+		id := -int32(len(p.placeholders) + 1)
+		p.placeholders[d.projectId+"/"+d.identifier] = id
+		p.gais[id] = d.identifier
+		pr.Created = append(pr.Created, change)
+		return true, id, nil
+	}
+	// Name is unknown for assets stored by older app versions.
+	if current.Name.Valid {
+		if current.Name.String != d.name {
+			renamed := change
+			renamed.From = common.Ptr(current.Name.String)
+			renamed.To = common.Ptr(d.name)
+			pr.Renamed = append(pr.Renamed, renamed)
+		}
+		if current.LocationalParentID != null.Int32FromPtr(d.parentLocationalAssetId) {
+			if err := p.loadGAIs(d.config, d.projectId); err != nil {
+				return false, 0, err
+			}
+			moved := change
+			moved.From = p.parentGAI(current.LocationalParentID.Ptr())
+			moved.To = p.parentGAI(d.parentLocationalAssetId)
+			pr.Moved = append(pr.Moved, moved)
+		}
+	}
+	return false, current.AssetID.Int32, nil
+}
+
+func (p *assetPreview) loadGAIs(config apiserver.Configuration, projectId string) error {
+	if p.loaded[projectId] {
+		return nil
+	}
+	assets, err := conf.GetAssetsByGAI(context.Background(), config, projectId)
+	if err != nil {
+		return fmt.Errorf("fetching assets for project %s: %v", projectId, err)
+	}
+	for gai, ast := range assets {
+		if ast.AssetID.Valid {
+			p.gais[ast.AssetID.Int32] = gai
+		}
+	}
+	p.loaded[projectId] = true
+	return nil
+}
+
+func (p *assetPreview) parentGAI(id *int32) *string {
+	if id == nil {
+		return nil
+	}
+	if gai, ok := p.gais[*id]; ok {
+		return &gai
+	}
+	return common.Ptr(formatAssetID(id))
+}
+
+// previewOrphanedAssets lists the assets RetireOrphanedAssets would treat as orphaned.
+func previewOrphanedAssets(config apiserver.Configuration, locations []model.Floor, systems []model.System, preview *assetPreview) error {
+	if !canDetectOrphans(config, systems) {
+		return nil
+	}
+	present := reportedGAIs(locations, systems)
+	for _, projectId := range conf.AllProjIds(config) {
+		assets, err := conf.GetAssetsByGAI(context.Background(), config, projectId)
+		if err != nil {
+			return fmt.Errorf("fetching assets for project %s: %v", projectId, err)
+		}
+		pr := preview.project(projectId)
+		for gai, ast := range assets {
+			if ast.AssetTypeName == rootAssetType || present[gai] || ast.RetiredAt.Valid {
+				continue
+			}
+			pr.Orphaned = append(pr.Orphaned, apiserver.AssetPreviewChange{
+				Gai:       gai,
+				AssetType: ast.AssetTypeName,
+				Name:      ast.Name.String,
+			})
+		}
+		sort.Slice(pr.Orphaned, func(i, j int) bool { return pr.Orphaned[i].Gai < pr.Orphaned[j].Gai })
+	}
+	return nil
+}
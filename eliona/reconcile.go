@@ -0,0 +1,190 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package eliona
+
+import (
+	"abb-free-at-home/apiserver"
+	"abb-free-at-home/appdb"
+	"abb-free-at-home/conf"
+	"abb-free-at-home/model"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/eliona-smart-building-assistant/go-utils/log"
+	"github.com/volatiletech/null/v8"
+)
+
+// changeReport collects the changes of existing assets found during one synchronization.
+// A nil report ignores all changes, which is used for freshly created assets.
+type changeReport struct {
+	changes []string
+}
+
+func (r *changeReport) add(format string, args ...any) {
+	if r == nil {
+		return
+	}
+	r.changes = append(r.changes, fmt.Sprintf(format, args...))
+}
+
+func (r *changeReport) log(config apiserver.Configuration, projectId string) {
+	if r == nil || len(r.changes) == 0 {
+		return
+	}
+	log.Info("Eliona", "config %d, project %s: %d changes of existing assets:\n%s", *config.Id, projectId, len(r.changes), strings.Join(r.changes, "\n"))
+}
+
+// ReconcileMappings brings the datapoints and attribute links of all existing channel
+// assets of the configuration in line with the current ABB systems, e.g. after an app
+// upgrade added attributes to a device type. Assets are neither created nor updated.
+// Running it repeatedly is safe. Returns the changes made.
+func ReconcileMappings(config apiserver.Configuration, systems []model.System) ([]string, error) {
+	datapoints, err := conf.GetDatapointsByAsset(context.Background(), config)
+	if err != nil {
+		return nil, fmt.Errorf("fetching datapoints: %v", err)
+	}
+	var changes []string
+	for _, projectId := range conf.AllProjIds(config) {
+		assets, err := conf.GetAssetsByGAI(context.Background(), config, projectId)
+		if err != nil {
+			return nil, fmt.Errorf("fetching assets for project %s: %v", projectId, err)
+		}
+		report := &changeReport{}
+		for _, system := range systemsInProject(config, systems, projectId) {
+			for _, device := range system.Devices {
+				for _, channel := range device.Channels {
+					ast, ok := assets[channel.GAI()]
+					if !ok || !ast.AssetID.Valid || ast.RetiredAt.Valid {
+						continue
+					}
+					if err := reconcileDatapoints(datapoints[ast.AssetID.Int32], ast.AssetID.Int32, system.ID, device.ID, channel, report); err != nil {
+						return nil, fmt.Errorf("reconciling datapoints of channel %s: %v", channel.GAI(), err)
+					}
+				}
+			}
+		}
+		report.log(config, projectId)
+		changes = append(changes, report.changes...)
+	}
+	return changes, nil
+}
+
+// reconcileDatapoints brings the stored datapoints of the channel asset and their
+// attribute links in line with the current ABB channel: missing ones are inserted,
+// stale ones removed and changed ones updated.
+func reconcileDatapoints(existing []*appdb.Datapoint, assetID int32, systemID, deviceID string, channel model.Asset, report *changeReport) error {
+	ctx := context.Background()
+	gai := channel.GAI()
+	inputs := channel.Inputs()
+	outputs := channel.Outputs()
+	seenInputs := make(map[string]bool)
+	seenOutputs := make(map[string]bool)
+	for _, dp := range existing {
+		var name, dpt string
+		var wanted bool
+		if dp.IsInput {
+			name, wanted = inputs[dp.Function]
+			wanted = wanted && !seenInputs[dp.Function]
+			seenInputs[dp.Function] = true
+		} else {
+			var output model.Datapoint
+			output, wanted = outputs[dp.Function]
+			wanted = wanted && !seenOutputs[dp.Function]
+			seenOutputs[dp.Function] = true
+			name, dpt = output.Name, output.Dpt
+		}
+		if !wanted {
+			report.add("%s: removed datapoint %s (%s)", gai, dp.Datapoint, dp.Function)
+			if err := conf.DeleteDatapoint(ctx, dp); err != nil {
+				return fmt.Errorf("deleting datapoint %d: %v", dp.ID, err)
+			}
+			continue
+		}
+		if dp.Datapoint != name || dp.SystemID != systemID || dp.DeviceID != deviceID || dp.ChannelID != channel.Id() ||
+			(!dp.IsInput && dp.DPT.String != dpt) {
+			report.add("%s: datapoint %s (%s) changed to %s", gai, dp.Datapoint, dp.Function, name)
+			dp.Datapoint = name
+			dp.SystemID = systemID
+			dp.DeviceID = deviceID
+			dp.ChannelID = channel.Id()
+			if !dp.IsInput {
+				dp.DPT = null.NewString(dpt, dpt != "")
+			}
+			if err := conf.UpdateDatapoint(*dp); err != nil {
+				return fmt.Errorf("updating datapoint %d: %v", dp.ID, err)
+			}
+		}
+		if !dp.IsInput {
+			if err := reconcileAttributeLinks(dp, outputs[dp.Function].Map, gai, report); err != nil {
+				return err
+			}
+		}
+	}
+
+	for function, datapoint := range inputs {
+		if seenInputs[function] {
+			continue
+		}
+		report.add("%s: added input %s (%s)", gai, datapoint, function)
+		if _, err := conf.InsertInput(assetID, systemID, deviceID, channel.Id(), datapoint, function); err != nil {
+			return fmt.Errorf("inserting input: %v", err)
+		}
+	}
+	for function, datapoint := range outputs {
+		if seenOutputs[function] {
+			continue
+		}
+		report.add("%s: added output %s (%s)", gai, datapoint.Name, function)
+		dpId, err := conf.InsertOutput(assetID, systemID, deviceID, channel.Id(), datapoint.Name, datapoint.Dpt, function)
+		if err != nil {
+			return fmt.Errorf("inserting output: %v", err)
+		}
+		for _, attr := range datapoint.Map {
+			if err := conf.LinkDatapointToAttribute(dpId, string(attr.Subtype), attr.AttributeName); err != nil {
+				return fmt.Errorf("inserting datapoint-attribute link: %v", err)
+			}
+		}
+	}
+	return nil
+}
+
+func reconcileAttributeLinks(dp *appdb.Datapoint, attrs model.DatapointMap, gai string, report *changeReport) error {
+	type link struct{ subtype, attribute string }
+	wanted := make(map[link]bool)
+	for _, attr := range attrs {
+		wanted[link{string(attr.Subtype), attr.AttributeName}] = true
+	}
+	for _, existing := range dp.R.GetDatapointAttributes() {
+		l := link{existing.Subtype, existing.AttributeName}
+		if wanted[l] {
+			// Also drops duplicates, as the second one is no longer wanted.
+			delete(wanted, l)
+			continue
+		}
+		report.add("%s: unlinked datapoint %s from %s/%s", gai, dp.Datapoint, l.subtype, l.attribute)
+		if err := conf.UnlinkDatapointAttribute(context.Background(), existing); err != nil {
+			return fmt.Errorf("deleting datapoint-attribute link %d: %v", existing.ID, err)
+		}
+	}
+	for l := range wanted {
+		report.add("%s: linked datapoint %s to %s/%s", gai, dp.Datapoint, l.subtype, l.attribute)
+		if err := conf.LinkDatapointToAttribute(dp.ID, l.subtype, l.attribute); err != nil {
+			return fmt.Errorf("inserting datapoint-attribute link: %v", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,193 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package eliona
+
+import (
+	"abb-free-at-home/apiserver"
+	"abb-free-at-home/appdb"
+	"abb-free-at-home/conf"
+	"context"
+	"fmt"
+	"time"
+
+	api "github.com/eliona-smart-building-assistant/go-eliona-api-client/v2"
+	"github.com/eliona-smart-building-assistant/go-eliona/asset"
+	"github.com/eliona-smart-building-assistant/go-utils/common"
+	"github.com/eliona-smart-building-assistant/go-utils/log"
+)
+
+// GetSuppressedAssets lists the assets deleted in Eliona. upsertAsset leaves them alone, so
+// they stay deleted until they are re-created or forgotten.
+func GetSuppressedAssets(config apiserver.Configuration) ([]apiserver.SuppressedAsset, error) {
+	suppressed, err := suppressedAssets(config)
+	if err != nil {
+		return nil, err
+	}
+	result := []apiserver.SuppressedAsset{}
+	for _, a := range suppressed {
+		result = append(result, apiSuppressedAsset(a))
+	}
+	return result, nil
+}
+
+// RecreateSuppressedAssets creates the selected suppressed assets in Eliona again and links their
+// datapoints to them. The next discovery completes their descriptions and parents.
+func RecreateSuppressedAssets(config apiserver.Configuration, assetIDs []int32) ([]apiserver.SuppressedAsset, error) {
+	suppressed, err := suppressedAssets(config)
+	if err != nil {
+		return nil, err
+	}
+	selected, err := selectSuppressedAssets(suppressed, assetIDs)
+	if err != nil {
+		return nil, err
+	}
+	stillDeleted := make(map[int32]bool)
+	for _, a := range suppressed {
+		stillDeleted[a.AssetID.Int32] = true
+	}
+	recreated := make(map[int32]int32)
+	result := []apiserver.SuppressedAsset{}
+	// Suppressed assets are ordered by creation, so parents are re-created before their children.
+	for _, a := range selected {
+		oldID := a.AssetID.Int32
+		parent := a.LocationalParentID.Ptr()
+		if parent != nil {
+			if newID, ok := recreated[*parent]; ok {
+				parent = &newID
+			} else if stillDeleted[*parent] {
+				parent = nil
+			}
+		}
+		name := a.Name.String
+		if !a.Name.Valid {
+			name = a.GlobalAssetID
+		}
+		newID, err := asset.UpsertAsset(api.Asset{
+			ProjectId:               a.ProjectID,
+			GlobalAssetIdentifier:   conf.ElionaGAI(config, a.GlobalAssetID),
+			Name:                    *api.NewNullableString(common.Ptr(name)),
+			AssetType:               a.AssetTypeName,
+			Description:             *api.NewNullableString(common.Ptr(fmt.Sprintf("%s (%v)", name, a.GlobalAssetID))),
+			ParentFunctionalAssetId: *api.NewNullableInt32(parent),
+			ParentLocationalAssetId: *api.NewNullableInt32(parent),
+			IsTracker:               *api.NewNullableBool(common.Ptr(false)),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("re-creating asset '%s' in Eliona: %v", a.GlobalAssetID, err)
+		}
+		if newID == nil {
+			return nil, fmt.Errorf("cannot re-create asset '%s'", a.GlobalAssetID)
+		}
+		recreatedAsset := apiSuppressedAsset(a)
+		if err := conf.RelinkAsset(context.Background(), a, *newID); err != nil {
+			return nil, fmt.Errorf("relinking asset '%s': %v", a.GlobalAssetID, err)
+		}
+		log.Info("Eliona", "re-created asset '%s' deleted in Eliona as %d", a.GlobalAssetID, *newID)
+		recreated[oldID] = *newID
+		delete(stillDeleted, oldID)
+		recreatedAsset.RecreatedAssetId = newID
+		result = append(result, recreatedAsset)
+	}
+	return result, nil
+}
+
+// ForgetSuppressedAssets drops the datapoints of the selected suppressed assets. They are not
+// listed or created again.
+func ForgetSuppressedAssets(config apiserver.Configuration, assetIDs []int32) ([]apiserver.SuppressedAsset, error) {
+	suppressed, err := suppressedAssets(config)
+	if err != nil {
+		return nil, err
+	}
+	selected, err := selectSuppressedAssets(suppressed, assetIDs)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	result := []apiserver.SuppressedAsset{}
+	for _, a := range selected {
+		forgotten := apiSuppressedAsset(a)
+		if err := conf.ForgetAsset(context.Background(), a, now); err != nil {
+			return nil, fmt.Errorf("forgetting asset '%s': %v", a.GlobalAssetID, err)
+		}
+		log.Info("Eliona", "forgot asset '%s' deleted in Eliona", a.GlobalAssetID)
+		result = append(result, forgotten)
+	}
+	return result, nil
+}
+
+func suppressedAssets(config apiserver.Configuration) ([]*appdb.Asset, error) {
+	linked, err := conf.GetLinkedAssets(context.Background(), config)
+	if err != nil {
+		return nil, fmt.Errorf("fetching assets: %v", err)
+	}
+	existing := make(map[string]map[int32]bool)
+	var suppressed []*appdb.Asset
+	for _, a := range linked {
+		ids, ok := existing[a.ProjectID]
+		if !ok {
+			byGAI, err := AssetIDsByGAI(a.ProjectID)
+			if err != nil {
+				return nil, fmt.Errorf("fetching Eliona assets of project %s: %v", a.ProjectID, err)
+			}
+			ids = make(map[int32]bool, len(byGAI))
+			for _, id := range byGAI {
+				ids[id] = true
+			}
+			existing[a.ProjectID] = ids
+		}
+		if !ids[a.AssetID.Int32] {
+			suppressed = append(suppressed, a)
+		}
+	}
+	return suppressed, nil
+}
+
+// selectSuppressedAssets returns the suppressed assets with the given Eliona IDs, or all if none
+// are given.
+func selectSuppressedAssets(suppressed []*appdb.Asset, assetIDs []int32) ([]*appdb.Asset, error) {
+	if len(assetIDs) == 0 {
+		return suppressed, nil
+	}
+	wanted := make(map[int32]bool, len(assetIDs))
+	for _, id := range assetIDs {
+		wanted[id] = true
+	}
+	var selected []*appdb.Asset
+	for _, a := range suppressed {
+		if wanted[a.AssetID.Int32] {
+			selected = append(selected, a)
+			delete(wanted, a.AssetID.Int32)
+		}
+	}
+	for id := range wanted {
+		return nil, fmt.Errorf("%w: asset %d is not suppressed", conf.ErrBadRequest, id)
+	}
+	return selected, nil
+}
+
+func apiSuppressedAsset(a *appdb.Asset) apiserver.SuppressedAsset {
+	suppressed := apiserver.SuppressedAsset{
+		AssetId:   a.AssetID.Int32,
+		ProjectId: a.ProjectID,
+		Gai:       a.GlobalAssetID,
+		AssetType: a.AssetTypeName,
+		Name:      a.Name.String,
+	}
+	if a.R != nil {
+		suppressed.Datapoints = int32(len(a.R.Datapoints))
+	}
+	return suppressed
+}
@@ -4,6 +4,18 @@ go install github.com/volatiletech/sqlboiler/v4/drivers/sqlboiler-psql@latest
 go get github.com/volatiletech/sqlboiler/v4
 go get github.com/volatiletech/null/v8
 
+REM Prepare the schema like the app does at startup: init.sql followed by all migrations. The
+REM connection defaults to the one in sqlboiler.toml and can be changed with the PG* variables.
+if not defined PGHOST set PGHOST=localhost
+if not defined PGPORT set PGPORT=5432
+if not defined PGDATABASE set PGDATABASE=postgres
+if not defined PGUSER set PGUSER=postgres
+if not defined PGPASSWORD set PGPASSWORD=secret
+psql -q -v ON_ERROR_STOP=1 -f conf\init.sql || exit /b 1
+for %%f in (conf\migrations\*.up.sql) do (
+    psql -q -v ON_ERROR_STOP=1 -f %%f || exit /b 1
+)
+
 sqlboiler psql ^
     -c sqlboiler.toml ^
     --wipe --no-tests
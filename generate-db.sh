@@ -4,6 +4,14 @@ go install github.com/volatiletech/sqlboiler/v4/drivers/sqlboiler-psql@latest
 go get github.com/volatiletech/sqlboiler/v4
 go get github.com/volatiletech/null/v8
 
+# Prepare the schema like the app does at startup: init.sql followed by all migrations. The
+# connection defaults to the one in sqlboiler.toml and can be changed with the PG* variables.
+export PGHOST="${PGHOST:-localhost}" PGPORT="${PGPORT:-5432}" PGDATABASE="${PGDATABASE:-postgres}"
+export PGUSER="${PGUSER:-postgres}" PGPASSWORD="${PGPASSWORD:-secret}"
+for file in conf/init.sql conf/migrations/*.up.sql; do
+    psql -q -v ON_ERROR_STOP=1 -f "$file" || exit 1
+done
+
 sqlboiler psql \
     -c sqlboiler.toml \
     --wipe --no-tests
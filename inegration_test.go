@@ -19,7 +19,7 @@ func TestApp(t *testing.T) {
 func schema(t *testing.T) {
 	t.Parallel()
 
-	assert.SchemaExists(t, "abb_free_at_home", []string{"configuration", "asset", "datapoint", "datapoint_attribute"})
+	assert.SchemaExists(t, "abb_free_at_home", []string{"configuration", "asset", "datapoint", "datapoint_attribute", "sync_watermark", "datapoint_watermark", "buffered_data", "schema_migration", "device_alert", "alarm_rule", "system_connection_event", "sync_job"})
 }
 
 func assetTypes(t *testing.T) {
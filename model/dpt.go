@@ -0,0 +1,116 @@
+package model
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Main numbers of the KNX datapoint types reported by ABB for the datapoints.
+const (
+	dptBoolean        = 1
+	dptUnsigned8Bit   = 5
+	dptSigned8Bit     = 6
+	dptUnsigned16Bit  = 7
+	dptSigned16Bit    = 8
+	dptFloat16Bit     = 9
+	dptTimeOfDay      = 10
+	dptDate           = 11
+	dptUnsigned32Bit  = 12
+	dptSigned32Bit    = 13
+	dptFloat32Bit     = 14
+	dptString         = 16
+	dptSceneNumber    = 17
+	dptDateTime       = 19
+	dptEnum8Bit       = 20
+	dptColourRGB      = 232
+	dptColourHSVOrRGB = 251
+)
+
+// Accepts the usual notations, e.g. "1.001", "DPT1.001", "DPST-1-1" or "DPT_1_001".
+var dptPattern = regexp.MustCompile(`(\d+)\D+(\d+)`)
+
+func dptMainNumber(dpt string) (int, bool) {
+	m := dptPattern.FindStringSubmatch(dpt)
+	if m == nil {
+		return 0, false
+	}
+	main, err := strconv.Atoi(m[1])
+	if err != nil {
+		return 0, false
+	}
+	return main, true
+}
+
+// ConvertValue converts a datapoint value reported by ABB according to its
+// datapoint type. Values of unknown types are guessed from the string.
+func ConvertValue(value, dpt string) any {
+	main, ok := dptMainNumber(dpt)
+	if !ok {
+		return guessValue(value)
+	}
+	switch main {
+	case dptBoolean:
+		switch strings.ToLower(strings.TrimSpace(value)) {
+		case "1", "true", "on":
+			return 1
+		case "0", "false", "off":
+			return 0
+		}
+	case dptUnsigned8Bit, dptSigned8Bit, dptUnsigned16Bit, dptSigned16Bit,
+		dptUnsigned32Bit, dptSigned32Bit, dptSceneNumber, dptEnum8Bit:
+		if i, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return i
+		}
+		// Percentages are sometimes reported with decimals.
+		if f, err := strconv.ParseFloat(value, 64); err == nil {
+			return f
+		}
+	case dptFloat16Bit, dptFloat32Bit:
+		if f, err := strconv.ParseFloat(value, 64); err == nil {
+			return f
+		}
+	case dptTimeOfDay:
+		return formatTime(value, time.TimeOnly, time.TimeOnly, "15:04", time.RFC3339Nano)
+	case dptDate:
+		return formatTime(value, time.DateOnly, time.DateOnly, time.RFC3339Nano)
+	case dptDateTime:
+		return formatTime(value, time.RFC3339, time.RFC3339Nano, time.DateTime)
+	case dptString, dptColourRGB, dptColourHSVOrRGB:
+		// Colours are kept in the string form ABB uses (e.g. the HSV state).
+		return value
+	}
+	return guessValue(value)
+}
+
+// formatTime parses the value with the first matching layout and formats it with the given
+// layout. Values matching none of the layouts are returned as is.
+func formatTime(value, format string, layouts ...string) string {
+	value = strings.TrimSpace(value)
+	for _, layout := range layouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t.Format(format)
+		}
+	}
+	return value
+}
+
+// guessValue tries to convert a string to an integer or a float.
+// If conversion is not possible, it returns the original string.
+func guessValue(s string) any {
+	if strings.Contains(s, ".") {
+		// Try converting to float
+		val, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return s
+		}
+		return val
+	}
+	// Try converting to integer
+	val, err := strconv.Atoi(s)
+	if err != nil {
+		return s
+	}
+	return val
+}
@@ -0,0 +1,38 @@
+package model
+
+import "testing"
+
+func TestConvertValue(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		dpt   string
+		want  any
+	}{
+		{"boolean on", "1", "1.001", 1},
+		{"boolean word", "Off", "DPT1.001", 0},
+		{"unsigned", "42", "DPST-5-1", int64(42)},
+		{"percentage with decimals", "42.5", "5.001", 42.5},
+		{"float", "21.5", "9.001", 21.5},
+		{"string", "hello", "16.000", "hello"},
+		{"colour", "120,50,100", "232.600", "120,50,100"},
+		{"time of day", "12:34:56", "10.001", "12:34:56"},
+		{"time of day without seconds", "07:05", "10.001", "07:05:00"},
+		{"time of day as RFC3339", "2024-01-01T12:34:56Z", "10.001", "12:34:56"},
+		{"invalid time of day", "noon", "10.001", "noon"},
+		{"date", "2024-02-29", "11.001", "2024-02-29"},
+		{"date as RFC3339", "2024-02-29T00:00:00+01:00", "11.001", "2024-02-29"},
+		{"invalid date", "2024-02-30", "11.001", "2024-02-30"},
+		{"date time", "2024-02-29T12:34:56.789+01:00", "19.001", "2024-02-29T12:34:56+01:00"},
+		{"date time without zone", "2024-02-29 12:34:56", "19.001", "2024-02-29T12:34:56Z"},
+		{"unknown type", "3.5", "", 3.5},
+		{"unknown type integer", "3", "", 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ConvertValue(tt.value, tt.dpt); got != tt.want {
+				t.Errorf("ConvertValue(%q, %q) = %#v, want %#v", tt.value, tt.dpt, got, tt.want)
+			}
+		})
+	}
+}
@@ -3,6 +3,8 @@ package model
 import (
 	"abb-free-at-home/apiserver"
 	"fmt"
+	"maps"
+	"time"
 
 	api "github.com/eliona-smart-building-assistant/go-eliona-api-client/v2"
 	"github.com/eliona-smart-building-assistant/go-eliona/utils"
@@ -10,10 +12,11 @@ import (
 )
 
 type Floor struct {
-	Id    string
-	Name  string
-	Level string `eliona:"level" subtype:"info"`
-	Rooms []Room
+	Id       string
+	SystemID string
+	Name     string
+	Level    string `eliona:"level" subtype:"info"`
+	Rooms    []Room
 }
 
 func (f Floor) AssetType() string {
@@ -42,7 +45,11 @@ type System struct {
 	GAI              string `eliona:"system_id,filterable"`
 	Name             string `eliona:"system_name,filterable"`
 	ConnectionStatus int8   `eliona:"connection_status" subtype:"status"`
+	FirmwareVersion  string `eliona:"sysap_firmware_version,filterable" subtype:"info"`
+	Version          string `eliona:"sysap_version,filterable" subtype:"info"`
+	ConnectionKnown  bool   // Whether ABB reported the connection status at all.
 	Devices          []Device
+	Filtered         []string // GAIs of devices and channels reported by ABB but excluded by the asset filter.
 }
 
 func (s System) AssetType() string {
@@ -56,7 +63,23 @@ type Device struct {
 	Location     string
 	Battery      *int64 `eliona:"battery" subtype:"status"`
 	Connectivity string `eliona:"connectivity" subtype:"status"`
-	Channels     []Asset
+	// Parsed from the connectivity, nil if not a wireless device or in an unknown format.
+	SignalQuality *int64 `eliona:"signal_quality" subtype:"status"`
+	Reachable     *int8  `eliona:"reachable" subtype:"status"`
+	// Alert states, set by the app with hysteresis applied.
+	LowBattery int8 `eliona:"low_battery" subtype:"status"`
+	WeakSignal int8 `eliona:"weak_signal" subtype:"status"`
+
+	Names    map[string]string // Translations of the name, keyed by language code
+	Channels []Asset
+
+	ArticleNumber   string `eliona:"article_number,filterable" subtype:"info"`
+	DeviceType      string `eliona:"device_type,filterable" subtype:"info"`
+	FirmwareVersion string `eliona:"firmware_version,filterable" subtype:"info"`
+	Manufacturer    string `eliona:"manufacturer,filterable" subtype:"info"`
+	SerialNumber    string `eliona:"serial_number,filterable" subtype:"info"`
+	Connection      string `eliona:"connection,filterable" subtype:"info"` // "wireless" or "wired", empty if unknown
+	LastSeen        string `eliona:"last_seen" subtype:"info"`
 }
 
 func (d Device) AssetType() string {
@@ -67,27 +90,39 @@ type Asset interface {
 	AssetType() string
 	GAI() string
 	Name() string
+	Names() map[string]string
 	Id() string
+	FunctionName() string
 	Inputs() map[string]string     // map[function]datapoint
 	Outputs() map[string]Datapoint // map[function]datapoint
 }
 
 type AssetBase struct {
-	IDBase      string `eliona:"channel_id,filterable"`
-	GAIBase     string
-	NameBase    string `eliona:"channel_name,filterable"`
-	InputsBase  map[string]string
-	OutputsBase map[string]Datapoint
+	IDBase       string `eliona:"channel_id,filterable"`
+	GAIBase      string
+	NameBase     string            `eliona:"channel_name,filterable"`
+	NamesBase    map[string]string // Translations of the name, keyed by language code
+	FunctionBase string            // Name of the channel type, e.g. "Switch actuator"
+	InputsBase   map[string]string
+	OutputsBase  map[string]Datapoint
 }
 
 func (a AssetBase) Name() string {
 	return a.NameBase
 }
 
+func (a AssetBase) Names() map[string]string {
+	return a.NamesBase
+}
+
 func (a AssetBase) Id() string {
 	return a.IDBase
 }
 
+func (a AssetBase) FunctionName() string {
+	return a.FunctionBase
+}
+
 func (a AssetBase) Inputs() map[string]string {
 	return a.InputsBase
 }
@@ -284,6 +319,58 @@ func (c MuteButton) GAI() string {
 	return fmt.Sprintf("%s_%s", c.AssetType(), c.GAIBase)
 }
 
+type DoorOpener struct {
+	AssetBase
+	DoorOpener int8 `eliona:"door_opener" subtype:"output"`
+}
+
+func (c DoorOpener) AssetType() string {
+	return "abb_free_at_home_door_opener"
+}
+
+func (c DoorOpener) GAI() string {
+	return fmt.Sprintf("%s_%s", c.AssetType(), c.GAIBase)
+}
+
+type AutomaticDoorOpener struct {
+	AssetBase
+	AutomaticDoorOpener int8 `eliona:"automatic_door_opener" subtype:"output"`
+}
+
+func (c AutomaticDoorOpener) AssetType() string {
+	return "abb_free_at_home_automatic_door_opener"
+}
+
+func (c AutomaticDoorOpener) GAI() string {
+	return fmt.Sprintf("%s_%s", c.AssetType(), c.GAIBase)
+}
+
+type StaircaseLight struct {
+	AssetBase
+	StaircaseLight int8 `eliona:"staircase_light" subtype:"output"`
+}
+
+func (c StaircaseLight) AssetType() string {
+	return "abb_free_at_home_staircase_light"
+}
+
+func (c StaircaseLight) GAI() string {
+	return fmt.Sprintf("%s_%s", c.AssetType(), c.GAIBase)
+}
+
+type DoorRingingSensor struct {
+	AssetBase
+	Ring int8 `eliona:"ring" subtype:"input"`
+}
+
+func (c DoorRingingSensor) AssetType() string {
+	return "abb_free_at_home_door_ringing_sensor"
+}
+
+func (c DoorRingingSensor) GAI() string {
+	return fmt.Sprintf("%s_%s", c.AssetType(), c.GAIBase)
+}
+
 type Scene struct {
 	AssetBase
 	Switch int8 `eliona:"set_scene" subtype:"output"`
@@ -317,11 +404,27 @@ func (c Wallbox) GAI() string {
 
 //
 
-func (sys *System) AdheresToFilter(filter [][]apiserver.FilterRule) (bool, error) {
+// ChannelFilterProperties are the channel properties that are not part of the channel,
+// device or system itself, but can be used in the asset filter.
+type ChannelFilterProperties struct {
+	FunctionID string `eliona:"function_id,filterable"`
+	AssetType  string `eliona:"asset_type,filterable"`
+	Floor      string `eliona:"floor,filterable"`
+	FloorLevel string `eliona:"floor_level,filterable"`
+	Room       string `eliona:"room,filterable"`
+}
+
+// AdheresToFilter checks the filterable properties of all the structs passed together
+// against the filter, so that one rule can combine e.g. system, device and channel properties.
+func AdheresToFilter(filter [][]apiserver.FilterRule, filterables ...any) (bool, error) {
 	f := apiFilterToCommonFilter(filter)
-	fp, err := utils.StructToMap(sys)
-	if err != nil {
-		return false, fmt.Errorf("converting struct to map: %v", err)
+	fp := make(map[string]string)
+	for _, filterable := range filterables {
+		m, err := utils.StructToMap(filterable)
+		if err != nil {
+			return false, fmt.Errorf("converting struct to map: %v", err)
+		}
+		maps.Copy(fp, m)
 	}
 	adheres, err := common.Filter(f, fp)
 	if err != nil {
@@ -330,6 +433,26 @@ func (sys *System) AdheresToFilter(filter [][]apiserver.FilterRule) (bool, error
 	return adheres, nil
 }
 
+// FindRoom returns the floor and room with the given ID, or nils if there is no such room.
+func FindRoom(locations []Floor, roomID string) (*Floor, *Room) {
+	for i := range locations {
+		for j := range locations[i].Rooms {
+			if locations[i].Rooms[j].Id == roomID {
+				return &locations[i], &locations[i].Rooms[j]
+			}
+		}
+	}
+	return nil, nil
+}
+
+// Localized returns the name in the language, or the fallback if ABB did not translate it.
+func Localized(names map[string]string, language, fallback string) string {
+	if name, ok := names[language]; ok {
+		return name
+	}
+	return fallback
+}
+
 func apiFilterToCommonFilter(input [][]apiserver.FilterRule) [][]common.FilterRule {
 	result := make([][]common.FilterRule, len(input))
 	for i := 0; i < len(input); i++ {
@@ -351,6 +474,9 @@ type DatapointMap []struct {
 
 // Datapoint maps ABB datapoint to multiple attributes in Eliona.
 type Datapoint struct {
-	Name string
-	Map  DatapointMap
+	Name  string
+	Value string    // Value as reported by ABB when the datapoint was fetched.
+	Dpt   string    // Datapoint type as reported by ABB, used to convert the values.
+	Time  time.Time // When the value last changed at ABB. Zero if unknown.
+	Map   DatapointMap
 }
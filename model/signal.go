@@ -0,0 +1,49 @@
+package model
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Signal quality in percent for the connectivity levels reported as words.
+var connectivityLevels = map[string]int64{
+	"excellent":   100,
+	"very good":   90,
+	"good":        75,
+	"medium":      50,
+	"fair":        50,
+	"weak":        25,
+	"poor":        25,
+	"bad":         10,
+	"none":        0,
+	"unreachable": 0,
+	"offline":     0,
+}
+
+// ParseSignalQuality converts the connectivity reported by ABB for wireless devices to
+// a signal quality in percent. ABB reports it either as a level like "good", as a
+// percentage or as a signal strength in dBm. Returns nil if the format is unknown.
+func ParseSignalQuality(connectivity string) *int64 {
+	c := strings.ToLower(strings.TrimSpace(connectivity))
+	if c == "" {
+		return nil
+	}
+	if q, ok := connectivityLevels[c]; ok {
+		return &q
+	}
+	if dbm, ok := strings.CutSuffix(c, "dbm"); ok {
+		v, err := strconv.ParseFloat(strings.TrimSpace(dbm), 64)
+		if err != nil {
+			return nil
+		}
+		// -100 dBm and below is unusable, -50 dBm and above is excellent.
+		q := int64(min(max(2*(v+100), 0), 100))
+		return &q
+	}
+	v, err := strconv.ParseFloat(strings.TrimSpace(strings.TrimSuffix(c, "%")), 64)
+	if err != nil || v < 0 || v > 100 {
+		return nil
+	}
+	q := int64(v)
+	return &q
+}
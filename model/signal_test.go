@@ -0,0 +1,46 @@
+package model
+
+import "testing"
+
+func TestParseSignalQuality(t *testing.T) {
+	tests := []struct {
+		connectivity string
+		want         *int64
+	}{
+		{"", nil},
+		{"Good", ptr(75)},
+		{" very good ", ptr(90)},
+		{"unreachable", ptr(0)},
+		{"-70 dBm", ptr(60)},
+		{"-70dbm", ptr(60)},
+		{"-120 dBm", ptr(0)},
+		{"-30 dBm", ptr(100)},
+		{"abc dBm", nil},
+		{"80%", ptr(80)},
+		{"55.5", ptr(55)},
+		{"150", nil},
+		{"-5%", nil},
+		{"wired", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.connectivity, func(t *testing.T) {
+			got := ParseSignalQuality(tt.connectivity)
+			switch {
+			case got == nil && tt.want == nil:
+			case got == nil || tt.want == nil || *got != *tt.want:
+				t.Errorf("ParseSignalQuality(%q) = %v, want %v", tt.connectivity, deref(got), deref(tt.want))
+			}
+		})
+	}
+}
+
+func ptr(v int64) *int64 {
+	return &v
+}
+
+func deref(v *int64) any {
+	if v == nil {
+		return nil
+	}
+	return *v
+}
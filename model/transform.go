@@ -0,0 +1,256 @@
+package model
+
+import (
+	"abb-free-at-home/apiserver"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Types of the transformation rules.
+const (
+	TransformScale  = "scale"
+	TransformEnum   = "enum"
+	TransformBits   = "bits"
+	TransformInvert = "invert"
+	TransformParse  = "parse"
+)
+
+// Formats of the parse rule.
+const (
+	parseInt    = "int"
+	parseFloat  = "float"
+	parseHex    = "hex"
+	parseBool   = "bool"
+	parseString = "string"
+)
+
+// ValidateTransformation checks that the rules are complete and can be reversed.
+func ValidateTransformation(rules []apiserver.TransformationRule) error {
+	for i, rule := range rules {
+		switch rule.Type {
+		case TransformScale:
+			if rule.Factor != nil && *rule.Factor == 0 {
+				return fmt.Errorf("rule %d: factor must not be 0", i)
+			}
+		case TransformEnum:
+			if len(rule.Mapping) == 0 {
+				return fmt.Errorf("rule %d: mapping must not be empty", i)
+			}
+			seen := make(map[string]bool)
+			for _, v := range rule.Mapping {
+				key := formatValue(v)
+				if seen[key] {
+					return fmt.Errorf("rule %d: value %v is mapped twice", i, v)
+				}
+				seen[key] = true
+			}
+		case TransformBits:
+			shift, width := bitField(rule)
+			if shift < 0 || width < 1 || shift+width > 63 {
+				return fmt.Errorf("rule %d: bits %d to %d out of range", i, shift, shift+width-1)
+			}
+		case TransformInvert:
+		case TransformParse:
+			if rule.Format == nil {
+				return fmt.Errorf("rule %d: format missing", i)
+			}
+			switch *rule.Format {
+			case parseInt, parseFloat, parseHex, parseBool, parseString:
+			default:
+				return fmt.Errorf("rule %d: unknown format '%s'", i, *rule.Format)
+			}
+		default:
+			return fmt.Errorf("rule %d: unknown type '%s'", i, rule.Type)
+		}
+	}
+	return nil
+}
+
+// Transform converts the value reported by ABB and applies the rules in order. A leading parse
+// rule gets the value as reported, otherwise it is converted according to its datapoint type first.
+func Transform(value, dpt string, rules []apiserver.TransformationRule) (any, error) {
+	var v any = value
+	if len(rules) == 0 || rules[0].Type != TransformParse {
+		v = ConvertValue(value, dpt)
+	}
+	for i, rule := range rules {
+		var err error
+		if v, err = applyRule(rule, v); err != nil {
+			return nil, fmt.Errorf("rule %d (%s): %v", i, rule.Type, err)
+		}
+	}
+	return v, nil
+}
+
+// ReverseTransform applies the inverse of the rules in reverse order to a value written in Eliona.
+func ReverseTransform(value any, rules []apiserver.TransformationRule) (any, error) {
+	v := value
+	for i := len(rules) - 1; i >= 0; i-- {
+		var err error
+		if v, err = reverseRule(rules[i], v); err != nil {
+			return nil, fmt.Errorf("rule %d (%s): %v", i, rules[i].Type, err)
+		}
+	}
+	return v, nil
+}
+
+func applyRule(rule apiserver.TransformationRule, v any) (any, error) {
+	switch rule.Type {
+	case TransformScale:
+		f, err := ToFloat(v)
+		if err != nil {
+			return nil, err
+		}
+		factor, offset := scale(rule)
+		return f*factor + offset, nil
+	case TransformEnum:
+		mapped, ok := rule.Mapping[formatValue(v)]
+		if !ok {
+			return nil, fmt.Errorf("no mapping for %v", v)
+		}
+		return mapped, nil
+	case TransformBits:
+		f, err := ToFloat(v)
+		if err != nil {
+			return nil, err
+		}
+		shift, width := bitField(rule)
+		return (int64(f) >> shift) & (1<<width - 1), nil
+	case TransformInvert:
+		f, err := ToFloat(v)
+		if err != nil {
+			return nil, err
+		}
+		if f == 0 {
+			return 1, nil
+		}
+		return 0, nil
+	case TransformParse:
+		s, ok := v.(string)
+		if !ok {
+			s = formatValue(v)
+		}
+		return parse(s, *rule.Format)
+	}
+	return nil, fmt.Errorf("unknown type")
+}
+
+func reverseRule(rule apiserver.TransformationRule, v any) (any, error) {
+	switch rule.Type {
+	case TransformScale:
+		f, err := ToFloat(v)
+		if err != nil {
+			return nil, err
+		}
+		factor, offset := scale(rule)
+		return (f - offset) / factor, nil
+	case TransformEnum:
+		key := formatValue(v)
+		for abbValue, elionaValue := range rule.Mapping {
+			if formatValue(elionaValue) == key {
+				return guessValue(abbValue), nil
+			}
+		}
+		return nil, fmt.Errorf("no ABB value mapped to %v", v)
+	case TransformBits:
+		f, err := ToFloat(v)
+		if err != nil {
+			return nil, err
+		}
+		// The other bits of the ABB value are unknown and written as 0.
+		shift, width := bitField(rule)
+		return (int64(f) & (1<<width - 1)) << shift, nil
+	case TransformInvert:
+		return applyRule(rule, v)
+	case TransformParse:
+		// Writes take the parsed number as is.
+		return v, nil
+	}
+	return nil, fmt.Errorf("unknown type")
+}
+
+func scale(rule apiserver.TransformationRule) (factor, offset float64) {
+	factor = 1
+	if rule.Factor != nil {
+		factor = *rule.Factor
+	}
+	if rule.Offset != nil {
+		offset = *rule.Offset
+	}
+	return factor, offset
+}
+
+func bitField(rule apiserver.TransformationRule) (shift, width int) {
+	width = 1
+	if rule.Shift != nil {
+		shift = int(*rule.Shift)
+	}
+	if rule.Width != nil {
+		width = int(*rule.Width)
+	}
+	return shift, width
+}
+
+func parse(s, format string) (any, error) {
+	s = strings.TrimSpace(s)
+	switch format {
+	case parseInt:
+		return strconv.ParseInt(s, 10, 64)
+	case parseFloat:
+		return strconv.ParseFloat(s, 64)
+	case parseHex:
+		return strconv.ParseInt(strings.TrimPrefix(strings.ToLower(s), "0x"), 16, 64)
+	case parseBool:
+		switch strings.ToLower(s) {
+		case "1", "true", "on":
+			return 1, nil
+		case "0", "false", "off":
+			return 0, nil
+		}
+		return nil, fmt.Errorf("not a boolean: '%s'", s)
+	case parseString:
+		return s, nil
+	}
+	return nil, fmt.Errorf("unknown format '%s'", format)
+}
+
+// ToFloat converts a number or a numeric string, as used for the values written to ABB.
+func ToFloat(v any) (float64, error) {
+	switch n := v.(type) {
+	case float64:
+		return n, nil
+	case float32:
+		return float64(n), nil
+	case int:
+		return float64(n), nil
+	case int8:
+		return float64(n), nil
+	case int32:
+		return float64(n), nil
+	case int64:
+		return float64(n), nil
+	case bool:
+		if n {
+			return 1, nil
+		}
+		return 0, nil
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(n), 64)
+		if err != nil {
+			return 0, fmt.Errorf("not a number: '%s'", n)
+		}
+		return f, nil
+	}
+	return 0, errors.New("value of unknown type")
+}
+
+// formatValue returns the string form used to look up values in enum mappings, so that
+// e.g. 1, 1.0 and "1" match the same key.
+func formatValue(v any) string {
+	if f, err := ToFloat(v); err == nil {
+		return strconv.FormatFloat(f, 'f', -1, 64)
+	}
+	return fmt.Sprint(v)
+}
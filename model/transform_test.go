@@ -0,0 +1,148 @@
+package model
+
+import (
+	"abb-free-at-home/apiserver"
+	"testing"
+
+	"github.com/eliona-smart-building-assistant/go-utils/common"
+)
+
+func TestValidateTransformation(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    apiserver.TransformationRule
+		wantErr bool
+	}{
+		{"scale", apiserver.TransformationRule{Type: TransformScale, Factor: common.Ptr(0.1)}, false},
+		{"scale by 0", apiserver.TransformationRule{Type: TransformScale, Factor: common.Ptr(0.0)}, true},
+		{"enum", apiserver.TransformationRule{Type: TransformEnum, Mapping: map[string]any{"0": "off", "1": "on"}}, false},
+		{"empty enum", apiserver.TransformationRule{Type: TransformEnum}, true},
+		{"enum not reversible", apiserver.TransformationRule{Type: TransformEnum, Mapping: map[string]any{"1": 1, "2": 1.0}}, true},
+		{"single bit", apiserver.TransformationRule{Type: TransformBits}, false},
+		{"highest bits", apiserver.TransformationRule{Type: TransformBits, Shift: common.Ptr[int32](60), Width: common.Ptr[int32](3)}, false},
+		{"bits beyond 63", apiserver.TransformationRule{Type: TransformBits, Shift: common.Ptr[int32](60), Width: common.Ptr[int32](4)}, true},
+		{"negative shift", apiserver.TransformationRule{Type: TransformBits, Shift: common.Ptr[int32](-1)}, true},
+		{"zero width", apiserver.TransformationRule{Type: TransformBits, Width: common.Ptr[int32](0)}, true},
+		{"invert", apiserver.TransformationRule{Type: TransformInvert}, false},
+		{"parse", apiserver.TransformationRule{Type: TransformParse, Format: common.Ptr(parseHex)}, false},
+		{"parse without format", apiserver.TransformationRule{Type: TransformParse}, true},
+		{"parse unknown format", apiserver.TransformationRule{Type: TransformParse, Format: common.Ptr("date")}, true},
+		{"unknown type", apiserver.TransformationRule{Type: "round"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateTransformation([]apiserver.TransformationRule{tt.rule})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateTransformation() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestTransformRoundTrip(t *testing.T) {
+	onOff := map[string]any{"0": "off", "1": "on"}
+	tests := []struct {
+		name        string
+		value       string
+		dpt         string
+		rules       []apiserver.TransformationRule
+		want        any
+		wantReverse any // The value written to ABB for want.
+	}{
+		{"scale", "21.5", "9.001", []apiserver.TransformationRule{
+			{Type: TransformScale, Factor: common.Ptr(2.0), Offset: common.Ptr(1.0)},
+		}, 44.0, 21.5},
+		{"enum", "1", "1.001", []apiserver.TransformationRule{
+			{Type: TransformEnum, Mapping: onOff},
+		}, "on", 1},
+		{"bits", "12", "5.001", []apiserver.TransformationRule{
+			{Type: TransformBits, Shift: common.Ptr[int32](2), Width: common.Ptr[int32](2)},
+		}, 3, 12},
+		{"bits drop other bits when written", "13", "5.001", []apiserver.TransformationRule{
+			{Type: TransformBits, Shift: common.Ptr[int32](2), Width: common.Ptr[int32](2)},
+		}, 3, 12},
+		{"highest bit", "4611686018427387904", "13.001", []apiserver.TransformationRule{
+			{Type: TransformBits, Shift: common.Ptr[int32](62)},
+		}, 1, 4611686018427387904},
+		{"invert", "1", "1.001", []apiserver.TransformationRule{
+			{Type: TransformInvert},
+		}, 0, 1},
+		{"parse hex", "0x1F", "16.000", []apiserver.TransformationRule{
+			{Type: TransformParse, Format: common.Ptr(parseHex)},
+		}, 31, 31},
+		{"parse bool", " on ", "16.000", []apiserver.TransformationRule{
+			{Type: TransformParse, Format: common.Ptr(parseBool)},
+		}, 1, 1},
+		{"parse and scale", "20.5", "16.000", []apiserver.TransformationRule{
+			{Type: TransformParse, Format: common.Ptr(parseFloat)},
+			{Type: TransformScale, Factor: common.Ptr(10.0)},
+		}, 205, 20.5},
+		{"invert and enum", "0", "1.001", []apiserver.TransformationRule{
+			{Type: TransformInvert},
+			{Type: TransformEnum, Mapping: onOff},
+		}, "on", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateTransformation(tt.rules); err != nil {
+				t.Fatalf("ValidateTransformation() error = %v", err)
+			}
+			got, err := Transform(tt.value, tt.dpt, tt.rules)
+			if err != nil {
+				t.Fatalf("Transform() error = %v", err)
+			}
+			if formatValue(got) != formatValue(tt.want) {
+				t.Errorf("Transform() = %v, want %v", got, tt.want)
+			}
+			reversed, err := ReverseTransform(got, tt.rules)
+			if err != nil {
+				t.Fatalf("ReverseTransform() error = %v", err)
+			}
+			if formatValue(reversed) != formatValue(tt.wantReverse) {
+				t.Errorf("ReverseTransform() = %v, want %v", reversed, tt.wantReverse)
+			}
+		})
+	}
+}
+
+func TestTransformErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		rule  apiserver.TransformationRule
+	}{
+		{"scale of text", "abc", apiserver.TransformationRule{Type: TransformScale}},
+		{"unmapped enum value", "2", apiserver.TransformationRule{Type: TransformEnum, Mapping: map[string]any{"1": "on"}}},
+		{"invalid boolean", "maybe", apiserver.TransformationRule{Type: TransformParse, Format: common.Ptr(parseBool)}},
+		{"invalid hex", "0xZZ", apiserver.TransformationRule{Type: TransformParse, Format: common.Ptr(parseHex)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, err := Transform(tt.value, "", []apiserver.TransformationRule{tt.rule}); err == nil {
+				t.Errorf("Transform() = %v, want error", got)
+			}
+		})
+	}
+}
+
+func TestEnumKeyNormalisation(t *testing.T) {
+	rule := apiserver.TransformationRule{Type: TransformEnum, Mapping: map[string]any{"1": "on", "2.5": 3.0}}
+	for _, v := range []any{1, int64(1), 1.0, float32(1), "1", " 1 ", "1.0", true} {
+		got, err := applyRule(rule, v)
+		if err != nil || got != "on" {
+			t.Errorf("applyRule(%#v) = %v, %v, want on", v, got, err)
+		}
+	}
+	if got, err := applyRule(rule, "2.50"); err != nil || got != 3.0 {
+		t.Errorf("applyRule(\"2.50\") = %v, %v, want 3", got, err)
+	}
+	for _, v := range []any{3, int64(3), 3.0, "3"} {
+		got, err := reverseRule(rule, v)
+		if err != nil || got != 2.5 {
+			t.Errorf("reverseRule(%#v) = %v, %v, want 2.5", v, got, err)
+		}
+	}
+	if got, err := reverseRule(rule, "on"); err != nil || got != 1 {
+		t.Errorf("reverseRule(\"on\") = %v, %v, want 1", got, err)
+	}
+}
@@ -0,0 +1,269 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package schedule
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Kind of a scheduled task of a configuration.
+type Kind string
+
+const (
+	// Discovery creates the assets for the entities reported by ABB and retires the missing ones.
+	Discovery Kind = "discovery"
+	// ValueRefresh writes the current values of all devices to Eliona.
+	ValueRefresh Kind = "refresh"
+)
+
+// Schedule defines when a recurring task runs.
+type Schedule struct {
+	Interval   time.Duration
+	Cron       *Cron       // Overrides the interval if set.
+	QuietHours *QuietHours // The task is postponed to the end of the quiet hours.
+}
+
+// Next returns the next time the task is due after the given time.
+func (s Schedule) Next(after time.Time) time.Time {
+	next := after.Add(s.Interval)
+	if s.Cron != nil {
+		next = s.Cron.Next(after)
+	}
+	if s.QuietHours != nil && s.QuietHours.Contains(next) {
+		next = s.QuietHours.End(next)
+	}
+	return next
+}
+
+// Overdue returns how long the task is late at the given time, if it last ran at the given
+// time. Pauses between the runs of a cron expression or quiet hours do not count.
+func (s Schedule) Overdue(lastRun, now time.Time) time.Duration {
+	return now.Sub(s.Next(lastRun))
+}
+
+// Cron is a parsed cron expression with the five fields minute, hour, day of month, month and
+// day of week. Fields support `*`, lists `1,2`, ranges `1-5` and steps `*/15`.
+type Cron struct {
+	minutes, hours, days, months, weekdays map[int]bool
+	anyDay, anyWeekday                     bool
+}
+
+var cronFields = []struct {
+	name     string
+	min, max int
+}{
+	{"minute", 0, 59},
+	{"hour", 0, 23},
+	{"day of month", 1, 31},
+	{"month", 1, 12},
+	{"day of week", 0, 6},
+}
+
+func ParseCron(expr string) (*Cron, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != len(cronFields) {
+		return nil, fmt.Errorf("cron expression '%s' must have %d fields", expr, len(cronFields))
+	}
+	sets := make([]map[int]bool, len(fields))
+	for i, field := range fields {
+		set, err := parseCronField(field, cronFields[i].min, cronFields[i].max)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s of cron expression '%s': %v", cronFields[i].name, expr, err)
+		}
+		sets[i] = set
+	}
+	return &Cron{
+		minutes:    sets[0],
+		hours:      sets[1],
+		days:       sets[2],
+		months:     sets[3],
+		weekdays:   sets[4],
+		anyDay:     fields[2] == "*",
+		anyWeekday: fields[4] == "*",
+	}, nil
+}
+
+func parseCronField(field string, min, max int) (map[int]bool, error) {
+	set := make(map[int]bool)
+	for _, part := range strings.Split(field, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(part, "/")
+		step := 1
+		if hasStep {
+			var err error
+			if step, err = strconv.Atoi(stepPart); err != nil || step <= 0 {
+				return nil, fmt.Errorf("invalid step '%s'", stepPart)
+			}
+		}
+		from, to := min, max
+		if rangePart != "*" {
+			fromPart, toPart, isRange := strings.Cut(rangePart, "-")
+			var err error
+			if from, err = strconv.Atoi(fromPart); err != nil {
+				return nil, fmt.Errorf("invalid value '%s'", fromPart)
+			}
+			to = from
+			if isRange {
+				if to, err = strconv.Atoi(toPart); err != nil {
+					return nil, fmt.Errorf("invalid value '%s'", toPart)
+				}
+			} else if hasStep {
+				to = max
+			}
+		}
+		if from < min || to > max || from > to {
+			return nil, fmt.Errorf("'%s' is out of range %d-%d", part, min, max)
+		}
+		for v := from; v <= to; v += step {
+			set[v] = true
+		}
+	}
+	return set, nil
+}
+
+// Next returns the first minute matching the expression after the given time, or the time one
+// year later if the expression never matches (e.g. 31st of February).
+func (c *Cron) Next(after time.Time) time.Time {
+	t := after.Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(1, 0, 0)
+	for t.Before(limit) {
+		switch {
+		case !c.months[int(t.Month())]:
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+		case !c.matchesDay(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+		case !c.hours[t.Hour()]:
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+		case !c.minutes[t.Minute()]:
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+	return limit
+}
+
+// matchesDay follows the cron convention: if both day of month and day of week are
+// restricted, a day matching either of them matches.
+func (c *Cron) matchesDay(t time.Time) bool {
+	day, weekday := c.days[t.Day()], c.weekdays[int(t.Weekday())]
+	switch {
+	case c.anyDay && c.anyWeekday:
+		return true
+	case c.anyDay:
+		return weekday
+	case c.anyWeekday:
+		return day
+	default:
+		return day || weekday
+	}
+}
+
+// QuietHours is a daily time window like `22:00-06:00`, which may span midnight.
+type QuietHours struct {
+	from, to time.Duration // Since midnight
+}
+
+func ParseQuietHours(s string) (*QuietHours, error) {
+	fromPart, toPart, ok := strings.Cut(s, "-")
+	if !ok {
+		return nil, fmt.Errorf("quiet hours '%s' must have the format HH:MM-HH:MM", s)
+	}
+	from, err := parseTimeOfDay(strings.TrimSpace(fromPart))
+	if err != nil {
+		return nil, fmt.Errorf("parsing quiet hours '%s': %v", s, err)
+	}
+	to, err := parseTimeOfDay(strings.TrimSpace(toPart))
+	if err != nil {
+		return nil, fmt.Errorf("parsing quiet hours '%s': %v", s, err)
+	}
+	if from == to {
+		return nil, fmt.Errorf("quiet hours '%s' are empty", s)
+	}
+	return &QuietHours{from: from, to: to}, nil
+}
+
+func parseTimeOfDay(s string) (time.Duration, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time of day '%s'", s)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// Contains reports whether the time is within the quiet hours.
+func (q *QuietHours) Contains(t time.Time) bool {
+	sinceMidnight := t.Sub(midnight(t))
+	if q.from < q.to {
+		return sinceMidnight >= q.from && sinceMidnight < q.to
+	}
+	return sinceMidnight >= q.from || sinceMidnight < q.to
+}
+
+// End returns the end of the quiet hours containing the time.
+func (q *QuietHours) End(t time.Time) time.Time {
+	end := midnight(t).Add(q.to)
+	if !end.After(t) {
+		end = midnight(t).AddDate(0, 0, 1).Add(q.to)
+	}
+	return end
+}
+
+func midnight(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+// triggers holds a channel per configuration and kind to run a task before it is due.
+var triggers = struct {
+	mu       sync.Mutex
+	channels map[string]chan struct{}
+}{channels: make(map[string]chan struct{})}
+
+func trigger(configID int64, kind Kind) chan struct{} {
+	triggers.mu.Lock()
+	defer triggers.mu.Unlock()
+	key := fmt.Sprintf("%s_%d", kind, configID)
+	ch, ok := triggers.channels[key]
+	if !ok {
+		// Buffered, so that a trigger is not lost while the task is running.
+		ch = make(chan struct{}, 1)
+		triggers.channels[key] = ch
+	}
+	return ch
+}
+
+// Trigger runs the task of the configuration as soon as possible.
+func Trigger(configID int64, kind Kind) {
+	// Non-blocking send, the task is already triggered if the buffer is full.
+	select {
+	case trigger(configID, kind) <- struct{}{}:
+	default:
+	}
+}
+
+// Wait blocks until the given time or until the task is triggered. It reports whether the
+// task was triggered.
+func Wait(configID int64, kind Kind, until time.Time) bool {
+	select {
+	case <-time.After(time.Until(until)):
+		return false
+	case <-trigger(configID, kind):
+		return true
+	}
+}
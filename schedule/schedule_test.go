@@ -0,0 +1,212 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+)
+
+func date(month time.Month, day, hour, min int) time.Time {
+	return time.Date(2024, month, day, hour, min, 0, 0, time.UTC)
+}
+
+func TestParseCron(t *testing.T) {
+	tests := []struct {
+		expr    string
+		wantErr bool
+	}{
+		{"* * * * *", false},
+		{"*/15 0-6 1,15 * 1-5", false},
+		{"5/20 * * * *", false},
+		{"0 22 * 1-3,10-12 0,6", false},
+		{"* * * *", true},
+		{"* * * * * *", true},
+		{"60 * * * *", true},
+		{"* 24 * * *", true},
+		{"* * 0 * *", true},
+		{"* * * 13 *", true},
+		{"* * * * 7", true},
+		{"*/0 * * * *", true},
+		{"5-1 * * * *", true},
+		{"a * * * *", true},
+		{"1-b * * * *", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			_, err := ParseCron(tt.expr)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseCron(%q) error = %v, wantErr %v", tt.expr, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseCronField(t *testing.T) {
+	tests := []struct {
+		field string
+		want  []int
+	}{
+		{"*/15", []int{0, 15, 30, 45}},
+		{"5/20", []int{5, 25, 45}},
+		{"10-20/5", []int{10, 15, 20}},
+		{"1,3,5", []int{1, 3, 5}},
+		{"58-59", []int{58, 59}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.field, func(t *testing.T) {
+			set, err := parseCronField(tt.field, 0, 59)
+			if err != nil {
+				t.Fatalf("parseCronField(%q) error = %v", tt.field, err)
+			}
+			if len(set) != len(tt.want) {
+				t.Errorf("parseCronField(%q) = %v, want %v", tt.field, set, tt.want)
+			}
+			for _, v := range tt.want {
+				if !set[v] {
+					t.Errorf("parseCronField(%q) misses %d", tt.field, v)
+				}
+			}
+		})
+	}
+}
+
+func TestCronNext(t *testing.T) {
+	// 1 January 2024 is a Monday.
+	monday := date(time.January, 1, 10, 7)
+	tests := []struct {
+		name  string
+		expr  string
+		after time.Time
+		want  time.Time
+	}{
+		{"every quarter hour", "*/15 * * * *", monday, date(time.January, 1, 10, 15)},
+		{"full hour", "0 * * * *", monday, date(time.January, 1, 11, 0)},
+		{"next day", "30 2 * * *", monday, date(time.January, 2, 2, 30)},
+		{"matching minute is excluded", "7 10 * * *", monday, date(time.January, 2, 10, 7)},
+		{"seconds are truncated", "* * * * *", monday.Add(30 * time.Second), date(time.January, 1, 10, 8)},
+		{"first of month", "0 0 1 * *", monday, date(time.February, 1, 0, 0)},
+		{"weekday", "0 0 * * 0", monday, date(time.January, 7, 0, 0)},
+		{"weekdays only", "0 8 * * 1-5", date(time.January, 5, 9, 0), date(time.January, 8, 8, 0)},
+		{"day of month or earlier weekday", "0 0 15 * 3", monday, date(time.January, 3, 0, 0)},
+		{"weekday or earlier day of month", "0 0 2 * 0", monday, date(time.January, 2, 0, 0)},
+		{"across the year", "0 0 1 1 *", date(time.December, 15, 0, 0), time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		{"never", "0 0 31 2 *", monday, time.Date(2025, time.January, 1, 10, 8, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cron, err := ParseCron(tt.expr)
+			if err != nil {
+				t.Fatalf("ParseCron(%q) error = %v", tt.expr, err)
+			}
+			if got := cron.Next(tt.after); !got.Equal(tt.want) {
+				t.Errorf("Next(%v) = %v, want %v", tt.after, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseQuietHours(t *testing.T) {
+	tests := []struct {
+		s       string
+		wantErr bool
+	}{
+		{"22:00-06:00", false},
+		{" 01:30 - 05:00 ", false},
+		{"22:00", true},
+		{"25:00-06:00", true},
+		{"22:00-6", true},
+		{"06:00-06:00", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			_, err := ParseQuietHours(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseQuietHours(%q) error = %v, wantErr %v", tt.s, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestQuietHours(t *testing.T) {
+	tests := []struct {
+		name         string
+		quietHours   string
+		at           time.Time
+		wantContains bool
+		wantEnd      time.Time
+	}{
+		{"night before midnight", "22:00-06:00", date(time.January, 1, 23, 0), true, date(time.January, 2, 6, 0)},
+		{"night after midnight", "22:00-06:00", date(time.January, 2, 5, 59), true, date(time.January, 2, 6, 0)},
+		{"night start", "22:00-06:00", date(time.January, 1, 22, 0), true, date(time.January, 2, 6, 0)},
+		{"night end", "22:00-06:00", date(time.January, 2, 6, 0), false, time.Time{}},
+		{"before night", "22:00-06:00", date(time.January, 1, 21, 59), false, time.Time{}},
+		{"within day", "01:00-05:00", date(time.January, 1, 3, 0), true, date(time.January, 1, 5, 0)},
+		{"before day window", "01:00-05:00", date(time.January, 1, 0, 30), false, time.Time{}},
+		{"after day window", "01:00-05:00", date(time.January, 1, 5, 0), false, time.Time{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := ParseQuietHours(tt.quietHours)
+			if err != nil {
+				t.Fatalf("ParseQuietHours(%q) error = %v", tt.quietHours, err)
+			}
+			if got := q.Contains(tt.at); got != tt.wantContains {
+				t.Errorf("Contains(%v) = %v, want %v", tt.at, got, tt.wantContains)
+			}
+			if !tt.wantContains {
+				return
+			}
+			if got := q.End(tt.at); !got.Equal(tt.wantEnd) {
+				t.Errorf("End(%v) = %v, want %v", tt.at, got, tt.wantEnd)
+			}
+		})
+	}
+}
+
+func TestScheduleOverdue(t *testing.T) {
+	workingHours, _ := ParseCron("0 8-17 * * 1-5")
+	quiet, _ := ParseQuietHours("22:00-06:00")
+	tests := []struct {
+		name     string
+		schedule Schedule
+		lastRun  time.Time
+		now      time.Time
+		want     time.Duration
+	}{
+		{"on time", Schedule{Interval: time.Minute}, date(time.January, 1, 10, 0), date(time.January, 1, 10, 1), 0},
+		{"late", Schedule{Interval: time.Minute}, date(time.January, 1, 10, 0), date(time.January, 1, 10, 31), 30 * time.Minute},
+		{"not yet due", Schedule{Interval: time.Hour}, date(time.January, 1, 10, 0), date(time.January, 1, 10, 30), -30 * time.Minute},
+		{"cron pause over the weekend", Schedule{Interval: time.Minute, Cron: workingHours}, date(time.January, 5, 17, 0), date(time.January, 8, 8, 0), 0},
+		{"late after cron pause", Schedule{Interval: time.Minute, Cron: workingHours}, date(time.January, 5, 17, 0), date(time.January, 8, 9, 5), 65 * time.Minute},
+		{"quiet hours", Schedule{Interval: time.Hour, QuietHours: quiet}, date(time.January, 1, 21, 30), date(time.January, 2, 6, 0), 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.schedule.Overdue(tt.lastRun, tt.now); got != tt.want {
+				t.Errorf("Overdue() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScheduleNext(t *testing.T) {
+	cron, _ := ParseCron("0 * * * *")
+	quiet, _ := ParseQuietHours("22:00-06:00")
+	tests := []struct {
+		name     string
+		schedule Schedule
+		after    time.Time
+		want     time.Time
+	}{
+		{"interval", Schedule{Interval: time.Hour}, date(time.January, 1, 10, 7), date(time.January, 1, 11, 7)},
+		{"cron overrides interval", Schedule{Interval: time.Minute, Cron: cron}, date(time.January, 1, 10, 7), date(time.January, 1, 11, 0)},
+		{"postponed by quiet hours", Schedule{Interval: time.Hour, QuietHours: quiet}, date(time.January, 1, 21, 30), date(time.January, 2, 6, 0)},
+		{"outside quiet hours", Schedule{Interval: time.Hour, QuietHours: quiet}, date(time.January, 1, 20, 30), date(time.January, 1, 21, 30)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.schedule.Next(tt.after); !got.Equal(tt.want) {
+				t.Errorf("Next(%v) = %v, want %v", tt.after, got, tt.want)
+			}
+		})
+	}
+}